@@ -0,0 +1,88 @@
+package main
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/latttchc/finding-forest-backend/internal/config"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/search"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+	"github.com/latttchc/finding-forest-backend/internal/validators"
+	"gorm.io/gorm"
+)
+
+// app はサーバーと管理コマンドで共有するリポジトリ・サービス
+type app struct {
+	cfg        *config.Config
+	db         *gorm.DB
+	validate   *validator.Validate
+	translator *validators.Translator
+
+	moderatorRepo repositories.ModeratorRepository
+
+	postService       services.PostService
+	commentService    services.CommentService
+	reactionService   services.ReactionService
+	reportService     services.ReportService
+	companyService    services.CompanyService
+	tagService        services.TagService
+	categoryService   services.CategoryService
+	authService       services.AuthService
+	moderationService services.ModerationService
+	exportService     services.ExportService
+}
+
+// newApp はリポジトリ・サービスを初期化する
+func newApp(cfg *config.Config, db *gorm.DB) (*app, error) {
+	// リポジトリ初期化
+	postRepo := repositories.NewPostRepository(db)
+	commentRepo := repositories.NewCommentRepository(db)
+	reactionRepo := repositories.NewReactionRepository(db)
+	reportRepo := repositories.NewReportRepository(db)
+	moderatorRepo := repositories.NewModeratorRepository(db)
+	auditLogRepo := repositories.NewAuditLogRepository(db)
+	companyRepo := repositories.NewCompanyRepository(db)
+	tagRepo := repositories.NewTagRepository(db)
+	categoryRepo := repositories.NewCategoryRepository(db)
+
+	// バリデーター初期化（サービス層と Echo で共有する）
+	// カテゴリはデータベースの有効なカテゴリで検証する
+	validate, err := validators.NewValidate(validators.Options{
+		Categories: validators.NewCategorySet(categoryRepo.ActiveNames, time.Minute),
+		NGWords:    cfg.Moderation.NGWords,
+	})
+	if err != nil {
+		return nil, err
+	}
+	translator, err := validators.NewTranslator(validate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 企業名補完用インデックス
+	suggestIndex := search.NewSuggestIndex()
+
+	// サービス初期化
+	companyService := services.NewCompanyService(companyRepo, suggestIndex, validate)
+	reportService := services.NewReportService(reportRepo, postRepo, commentRepo, companyService, validate, cfg.Moderation.ReportHideThreshold)
+
+	return &app{
+		cfg:           cfg,
+		db:            db,
+		validate:      validate,
+		translator:    translator,
+		moderatorRepo: moderatorRepo,
+
+		postService:       services.NewPostService(postRepo, commentRepo, reactionRepo, companyRepo, tagRepo, categoryRepo, suggestIndex, validate),
+		commentService:    services.NewCommentService(commentRepo, postRepo, reactionRepo, validate),
+		reactionService:   services.NewReactionService(reactionRepo, postRepo, commentRepo),
+		reportService:     reportService,
+		companyService:    companyService,
+		tagService:        services.NewTagService(tagRepo),
+		categoryService:   services.NewCategoryService(categoryRepo),
+		authService:       services.NewAuthService(moderatorRepo, auditLogRepo, validate, cfg.Moderation.SessionTTL),
+		moderationService: services.NewModerationService(repositories.NewTransactor(db), auditLogRepo, companyService, validate),
+		exportService:     services.NewExportService(postRepo),
+	}, nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+)
+
+// commandUsage は管理コマンドの一覧
+const commandUsage = `usage: server [command]
+
+引数なしで起動すると HTTP サーバーを起動します。
+
+commands:
+  migrate [up | down [N] | status]               マイグレーションの適用・取り消し・状況表示
+  seed [-posts N] [-comments N] [-seed S] [-days D] [-until DATE]
+                                                 デモデータの生成・投入
+  posts hide -moderator NAME [-reason R] ID...   投稿を非表示にする
+  posts restore -moderator NAME [-reason R] ID...
+                                                 非表示の投稿を表示に戻す
+  export [-o FILE]                               投稿とコメントを JSON Lines 形式で書き出す
+  moderator create -username NAME                モデレーターを作成する（パスワードは標準入力から読み込む）`
+
+// errUsage はコマンドの指定が誤っている場合のエラー
+var errUsage = errors.New(commandUsage)
+
+// runCommand は管理コマンドを実行する
+func runCommand(a *app, name string, args []string) error {
+	switch name {
+	case "migrate":
+		return runMigrate(a.db, args)
+	case "seed":
+		return runSeed(a, args)
+	case "posts":
+		return runPosts(a, args)
+	case "export":
+		return runExport(a, args)
+	case "moderator":
+		return runModerator(a, args)
+	default:
+		return errUsage
+	}
+}
+
+// isHelp は使い方の表示を求める引数かどうかを判定する
+func isHelp(arg string) bool {
+	return arg == "help" || arg == "-h" || arg == "--help"
+}
+
+// runPosts は投稿の非表示・再表示を行う
+// 操作は指定したモデレーターによるものとして監査ログに記録される
+func runPosts(a *app, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	var hidden bool
+	switch args[0] {
+	case "hide":
+		hidden = true
+	case "restore":
+		hidden = false
+	default:
+		return errUsage
+	}
+
+	flags := flag.NewFlagSet("posts "+args[0], flag.ContinueOnError)
+	username := flags.String("moderator", "", "操作を記録するモデレーターのユーザー名")
+	reason := flags.String("reason", "", "操作の理由")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *username == "" || flags.NArg() == 0 {
+		return errUsage
+	}
+
+	ids := make([]uint, flags.NArg())
+	for i, arg := range flags.Args() {
+		id, err := strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid post ID: %s", arg)
+		}
+		ids[i] = uint(id)
+	}
+
+	moderator, err := a.moderatorRepo.GetByUsername(*username)
+	if err != nil {
+		return fmt.Errorf("moderator not found: %w", err)
+	}
+
+	req := &models.ModerationActionRequest{Reason: *reason}
+	for _, id := range ids {
+		if err := a.moderationService.SetPostHidden(moderator.ID, id, hidden, req); err != nil {
+			return fmt.Errorf("post %d: %w", id, err)
+		}
+		log.Printf("Post %d: %s", id, args[0])
+	}
+	return nil
+}
+
+// runExport は投稿とコメントを JSON Lines 形式で書き出す
+func runExport(a *app, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := flags.String("o", "", "出力先のファイル（省略時は標準出力）")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return errUsage
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	buffered := bufio.NewWriter(w)
+	count, err := a.exportService.ExportPosts(buffered)
+	if err != nil {
+		return err
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	log.Printf("Exported %d post(s)", count)
+	return nil
+}
+
+// runModerator はモデレーターのアカウントを作成する
+// パスワードはコマンドライン引数に残らないよう標準入力の1行目から読み込む
+func runModerator(a *app, args []string) error {
+	if len(args) == 0 || args[0] != "create" {
+		return errUsage
+	}
+
+	flags := flag.NewFlagSet("moderator create", flag.ContinueOnError)
+	username := flags.String("username", "", "ユーザー名")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *username == "" || flags.NArg() > 0 {
+		return errUsage
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	moderator, err := a.authService.CreateModerator(&models.ModeratorCreateRequest{
+		Username: *username,
+		Password: strings.TrimRight(password, "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Created moderator %s (id=%d)", moderator.Username, moderator.ID)
+	return nil
+}
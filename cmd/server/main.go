@@ -1,21 +1,32 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
 	"github.com/latttchc/finding-forest-backend/internal/config"
 	"github.com/latttchc/finding-forest-backend/internal/handlers"
-	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/models"
 	"github.com/latttchc/finding-forest-backend/internal/services"
 	"github.com/latttchc/finding-forest-backend/internal/validators"
 	"github.com/latttchc/finding-forest-backend/pkg/database"
+	"gorm.io/gorm/logger"
 )
 
 func main() {
+	// 使い方の表示はデータベースに接続せずに行う
+	if len(os.Args) > 1 && isHelp(os.Args[1]) {
+		fmt.Println(commandUsage)
+		return
+	}
+
 	// 設定読み込み
 	cfg := config.Load()
 
@@ -25,36 +36,101 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	// データベースマイグレーション
-	if err := database.Migrate(db); err != nil {
-		log.Fatalf("Failed to migrate database: %v", err)
+	// 管理コマンドでは標準出力を結果の出力に使うため、SQL のログは警告のみ標準エラー出力に出す
+	if len(os.Args) > 1 {
+		db.Logger = logger.New(log.New(os.Stderr, "\r\n", log.LstdFlags), logger.Config{
+			SlowThreshold: 200 * time.Millisecond,
+			LogLevel:      logger.Warn,
+		})
+	}
+
+	// リポジトリ・サービス初期化
+	a, err := newApp(cfg, db)
+	if err != nil {
+		log.Fatalf("Failed to initialize application: %v", err)
 	}
 
-	// バリデーター初期化
-	validate := validator.New()
+	// サブコマンドが指定された場合は管理コマンドを実行して終了
+	if len(os.Args) > 1 {
+		if err := runCommand(a, os.Args[1], os.Args[2:]); err != nil {
+			log.Fatalf("%s: %v", os.Args[1], err)
+		}
+		return
+	}
 
-	// リポジトリ初期化
-	postRepo := repositories.NewPostRepository(db)
-	commentRepo := repositories.NewCommentRepository(db)
+	serve(a)
+}
 
-	// サービス初期化
-	postService := services.NewPostService(postRepo, commentRepo, validate)
-	commentService := services.NewCommentService(commentRepo, postRepo, validate)
+// serve は HTTP サーバーを起動する
+func serve(a *app) {
+	cfg := a.cfg
+
+	// データベースマイグレーション（無効にした場合は migrate サブコマンドで実行する）
+	if cfg.Database.AutoMigrate {
+		if err := database.Migrate(a.db); err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+	}
+
+	// 企業名補完用インデックスを構築し、他のレプリカでの更新を取り込むため定期的に再構築
+	if err := a.companyService.RefreshSuggestIndex(); err != nil {
+		log.Printf("Failed to build company suggest index: %v", err)
+	}
+	go func() {
+		for range time.Tick(cfg.App.SuggestRefreshInterval) {
+			if err := a.companyService.RefreshSuggestIndex(); err != nil {
+				log.Printf("Failed to refresh company suggest index: %v", err)
+			}
+		}
+	}()
+
+	// 初期モデレーター作成（環境変数で指定された場合のみ）
+	if cfg.Moderation.InitialUsername != "" && cfg.Moderation.InitialPassword != "" {
+		_, err := a.authService.CreateModerator(&models.ModeratorCreateRequest{
+			Username: cfg.Moderation.InitialUsername,
+			Password: cfg.Moderation.InitialPassword,
+		})
+		if err != nil && !errors.Is(err, services.ErrModeratorExists) {
+			log.Fatalf("Failed to create initial moderator: %v", err)
+		}
+	}
 
 	// ハンドラー初期化
-	postHandler := handlers.NewPostHandler(postService)
-	commentHandler := handlers.NewCommentHandler(commentService)
+	postHandler := handlers.NewPostHandler(a.postService)
+	commentHandler := handlers.NewCommentHandler(a.commentService)
+	reactionHandler := handlers.NewReactionHandler(a.reactionService)
+	reportHandler := handlers.NewReportHandler(a.reportService)
+	companyHandler := handlers.NewCompanyHandler(a.companyService, a.postService)
+	tagHandler := handlers.NewTagHandler(a.tagService)
+	categoryHandler := handlers.NewCategoryHandler(a.categoryService)
+	adminHandler := handlers.NewAdminHandler(a.authService, a.moderationService, a.reportService)
 
 	// Echo インスタンス作成
 	e := echo.New()
 
 	// カスタムバリデーター設定
-	e.Validator = validators.New()
+	e.Validator = validators.New(a.validate)
+
+	// バインドした入力値を検証・保存の前に正規化する
+	e.Binder = validators.NewBinder()
+
+	// エラーレスポンスを統一（ハンドラーはエラーを返すだけでよい）
+	e.HTTPErrorHandler = apperrors.NewHTTPErrorHandler(a.translator)
+
+	// 接続元IPアドレスの取得方法（通報の自動非表示の判定などに使用する）
+	// リクエスト元が偽装できないよう、プロキシのヘッダーは TRUST_PROXY を指定した場合のみ信頼する
+	if cfg.Server.TrustProxy {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
 
 	// ミドルウェア設定
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
+	// 項目ごとの文字数の検証より前に、極端に大きなリクエストを拒否する
+	e.Use(middleware.BodyLimit("1M"))
 
 	// ヘルスチェック
 	e.GET("/health", func(c echo.Context) error {
@@ -70,11 +146,56 @@ func main() {
 	api.GET("/posts", postHandler.GetPosts)
 	api.GET("/posts/:id", postHandler.GetPost)
 	api.POST("/posts", postHandler.CreatePost)
+	api.PUT("/posts/:id", postHandler.UpdatePost)
+	api.DELETE("/posts/:id", postHandler.DeletePost)
 
 	// コメント関連のルート
 	api.POST("/comments", commentHandler.CreateComment)
+	api.PUT("/comments/:id", commentHandler.UpdateComment)
+	api.DELETE("/comments/:id", commentHandler.DeleteComment)
 	api.GET("/posts/:post_id/comments", commentHandler.GetCommentsByPostID)
 
+	// リアクション関連のルート
+	api.PUT("/posts/:id/reactions/:kind", reactionHandler.AddPostReaction)
+	api.DELETE("/posts/:id/reactions/:kind", reactionHandler.RemovePostReaction)
+	api.PUT("/comments/:id/reactions/:kind", reactionHandler.AddCommentReaction)
+	api.DELETE("/comments/:id/reactions/:kind", reactionHandler.RemoveCommentReaction)
+
+	// 企業関連のルート
+	api.GET("/companies", companyHandler.GetCompanies)
+	api.GET("/companies/suggest", companyHandler.SuggestCompanies)
+	api.GET("/companies/:id", companyHandler.GetCompany)
+	api.GET("/companies/:id/posts", companyHandler.GetCompanyPosts)
+
+	// タグ関連のルート
+	api.GET("/tags", tagHandler.GetTags)
+
+	// カテゴリ関連のルート
+	api.GET("/categories", categoryHandler.GetCategories)
+
+	// 通報関連のルート
+	api.POST("/posts/:id/reports", reportHandler.ReportPost)
+	api.POST("/comments/:id/reports", reportHandler.ReportComment)
+
+	// 管理API ルート設定
+	admin := e.Group("/admin")
+	admin.POST("/login", adminHandler.Login)
+
+	// 以降はモデレーターのセッションが必要
+	moderator := admin.Group("", adminHandler.RequireModerator())
+	moderator.POST("/logout", adminHandler.Logout)
+	moderator.GET("/reports", adminHandler.GetReports)
+	moderator.POST("/reports/:id/resolve", adminHandler.ResolveReport)
+	moderator.POST("/posts/:id/hide", adminHandler.HidePost)
+	moderator.POST("/posts/:id/unhide", adminHandler.UnhidePost)
+	moderator.DELETE("/posts/:id", adminHandler.DeletePost)
+	moderator.POST("/comments/:id/hide", adminHandler.HideComment)
+	moderator.POST("/comments/:id/unhide", adminHandler.UnhideComment)
+	moderator.DELETE("/comments/:id", adminHandler.DeleteComment)
+	moderator.PUT("/companies/:id", adminHandler.UpdateCompany)
+	moderator.POST("/companies/:id/merge", adminHandler.MergeCompanies)
+	moderator.GET("/audit-logs", adminHandler.GetAuditLogs)
+
 	// サーバー起動
 	log.Printf("Server starting on port %s", cfg.Server.Port)
 	e.Logger.Fatal(e.Start(":" + cfg.Server.Port))
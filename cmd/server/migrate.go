@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/latttchc/finding-forest-backend/pkg/database"
+	"gorm.io/gorm"
+)
+
+// migrateUsage は migrate サブコマンドの使い方
+const migrateUsage = "usage: server migrate [up | down [N] | status]"
+
+// runMigrate は migrate サブコマンドを実行する
+// up: 未適用のマイグレーションをすべて適用 / down [N]: 新しいものから N 件（既定 1 件）取り消し / status: 適用状況を表示
+func runMigrate(db *gorm.DB, args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		if len(args) > 1 {
+			return errors.New(migrateUsage)
+		}
+		return database.Migrate(db)
+
+	case "down":
+		if len(args) > 2 {
+			return errors.New(migrateUsage)
+		}
+		steps := 1
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid number of steps: %s", args[1])
+			}
+			steps = n
+		}
+		count, err := database.MigrateDown(db, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Rolled back %d migration(s)\n", count)
+		return nil
+
+	case "status":
+		if len(args) > 1 {
+			return errors.New(migrateUsage)
+		}
+		statuses, err := database.MigrationStatuses(db)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-30s %s\n", status.Version, status.Name, appliedAt)
+		}
+		return nil
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/seed"
+	"gorm.io/gorm"
+)
+
+// defaultSeedUntil はデモデータの最も新しい日付の既定値
+// 実行日によって生成されるデータが変わらないよう、現在時刻ではなく固定の日付を使用する
+const defaultSeedUntil = "2025-03-31"
+
+// runSeed はデモデータを生成して投入する
+// 投稿・コメントはサービス層を経由して作成するため、通常の投稿と同じバリデーションが適用される
+func runSeed(a *app, args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	posts := flags.Int("posts", 50, "投稿数")
+	comments := flags.Int("comments", 8, "1投稿あたりの最大コメント数")
+	seedValue := flags.Uint64("seed", 1, "乱数のシード（同じシード・設定からは同じデータを生成する）")
+	days := flags.Int("days", 90, "投稿日時を分散させる日数")
+	untilValue := flags.String("until", defaultSeedUntil, "最も新しい日時（YYYY-MM-DD または RFC3339）")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 || *posts < 0 || *comments < 0 || *days <= 0 {
+		return errUsage
+	}
+
+	until, err := parseUntil(*untilValue)
+	if err != nil {
+		return fmt.Errorf("invalid until: %s", *untilValue)
+	}
+
+	// 投稿はすべての有効なカテゴリに順に割り当てる
+	categories, err := a.categoryService.GetCategories()
+	if err != nil {
+		return err
+	}
+	seedCategories := make([]seed.Category, len(categories))
+	for i, category := range categories {
+		seedCategories[i] = seed.Category{Name: category.Name, Interview: category.IsInterview}
+	}
+
+	generated := seed.Generate(seed.Options{
+		Seed:        *seedValue,
+		Posts:       *posts,
+		MaxComments: *comments,
+		Days:        *days,
+		Until:       until,
+		Categories:  seedCategories,
+	})
+
+	// 作成日時を再現するため、時計を差し替えたデータベースでサービスを初期化する
+	clock := seed.NewClock(until)
+	seeded, err := newApp(a.cfg, a.db.Session(&gorm.Session{NowFunc: clock.Now}))
+	if err != nil {
+		return err
+	}
+
+	result, err := seed.Run(generated, clock, seeded.postService, seeded.commentService)
+	if err != nil {
+		return err
+	}
+	log.Printf("Seeded %d post(s) and %d comment(s)", result.Posts, result.Comments)
+	return nil
+}
+
+// parseUntil は YYYY-MM-DD（日本時間のその日の終わり）または RFC3339 形式の日時を解析する
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, time.FixedZone("JST", 9*60*60)); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
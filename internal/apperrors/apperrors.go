@@ -0,0 +1,130 @@
+// Package apperrors はAPIが返すエラーの種類（コード）とHTTPステータスを表すエラー型を提供します
+// ハンドラーはこのパッケージのエラーを返すだけで、レスポンスへの変換は NewHTTPErrorHandler で作成したエラーハンドラーが行います
+package apperrors
+
+import "net/http"
+
+// Code はフロントエンドが分岐に使用する機械可読なエラーコードです
+type Code string
+
+// エラーコード
+const (
+	CodeValidationFailed   Code = "VALIDATION_FAILED"   // 入力値の検証エラー（details に項目ごとの内容）
+	CodeBadRequest         Code = "BAD_REQUEST"         // リクエストの形式・パラメーターの誤り
+	CodeUnauthorized       Code = "UNAUTHORIZED"        // 認証が必要・認証に失敗
+	CodeForbidden          Code = "FORBIDDEN"           // 操作の権限が無い（編集用トークンの不一致など）
+	CodeNotFound           Code = "NOT_FOUND"           // 対象が存在しない
+	CodeMethodNotAllowed   Code = "METHOD_NOT_ALLOWED"  // 許可されていないHTTPメソッド
+	CodeConflict           Code = "CONFLICT"            // 既存のデータと競合（重複した通報など）
+	CodeRateLimited        Code = "RATE_LIMITED"        // リクエスト数の上限を超過
+	CodeInternal           Code = "INTERNAL_ERROR"      // サーバー内部のエラー
+	CodeServiceUnavailable Code = "SERVICE_UNAVAILABLE" // データベースなどの一時的な障害
+)
+
+// Error はHTTPステータスとエラーコードを持つアプリケーションのエラーです
+// Err は原因となったエラーで、ログにのみ出力しレスポンスには含めません
+type Error struct {
+	Status  int
+	Code    Code
+	Message string
+	Details []FieldError
+	Err     error
+}
+
+// Error はエラーメッセージを返します
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap は原因となったエラーを返します
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap は原因となったエラーを設定したコピーを返します
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+// New は新しい Error を作成します
+func New(status int, code Code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// ValidationFailed は入力値の検証エラーを作成します
+// validator.ValidationErrors から項目ごとの内容を含めて作成する場合は Validation を使用してください
+func ValidationFailed(message string) *Error {
+	return New(http.StatusBadRequest, CodeValidationFailed, message)
+}
+
+// BadRequest はリクエストの形式・パラメーターの誤りを表すエラーを作成します
+func BadRequest(message string) *Error {
+	return New(http.StatusBadRequest, CodeBadRequest, message)
+}
+
+// Unauthorized は認証エラーを作成します
+func Unauthorized(message string) *Error {
+	return New(http.StatusUnauthorized, CodeUnauthorized, message)
+}
+
+// Forbidden は権限エラーを作成します
+func Forbidden(message string) *Error {
+	return New(http.StatusForbidden, CodeForbidden, message)
+}
+
+// NotFound は対象が存在しないことを表すエラーを作成します
+func NotFound(message string) *Error {
+	return New(http.StatusNotFound, CodeNotFound, message)
+}
+
+// Conflict は既存のデータとの競合を表すエラーを作成します
+func Conflict(message string) *Error {
+	return New(http.StatusConflict, CodeConflict, message)
+}
+
+// RateLimited はリクエスト数の上限超過を表すエラーを作成します
+func RateLimited(message string) *Error {
+	return New(http.StatusTooManyRequests, CodeRateLimited, message)
+}
+
+// Internal はサーバー内部のエラーを作成します
+// 原因の詳細はレスポンスに含めず、固定のメッセージを返します
+func Internal(err error) *Error {
+	return New(http.StatusInternalServerError, CodeInternal, "Internal server error").Wrap(err)
+}
+
+// ServiceUnavailable はデータベースなどの一時的な障害を表すエラーを作成します
+func ServiceUnavailable(err error) *Error {
+	return New(http.StatusServiceUnavailable, CodeServiceUnavailable, "Service temporarily unavailable").Wrap(err)
+}
+
+// codeForStatus はHTTPステータスに対応するエラーコードを返します
+func codeForStatus(status int) Code {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeBadRequest
+}
@@ -0,0 +1,68 @@
+package apperrors
+
+import (
+	"errors"
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/validators"
+)
+
+// Response はエラーレスポンスの構造体です
+type Response struct {
+	Error   string       `json:"error"`             // エラーメッセージ
+	Code    Code         `json:"code"`              // エラーコード
+	Details []FieldError `json:"details,omitempty"` // 入力項目ごとの検証エラー（VALIDATION_FAILED の場合のみ）
+}
+
+// NewHTTPErrorHandler はハンドラー・ミドルウェアが返したエラーをエラーレスポンスに変換する Echo のエラーハンドラーを作成します
+// 検証エラーは項目ごとの内容を Accept-Language の言語で返し、想定外のエラーは詳細を隠して 500 を返します
+func NewHTTPErrorHandler(translator *validators.Translator) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		trans := translator.FromAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+		e := From(err, trans)
+		if e.Status >= http.StatusInternalServerError {
+			c.Logger().Error(err)
+		}
+
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(e.Status)
+		} else {
+			err = c.JSON(e.Status, Response{Error: e.Message, Code: e.Code, Details: e.Details})
+		}
+		if err != nil {
+			c.Logger().Error(err)
+		}
+	}
+}
+
+// From は任意のエラーを Error に変換します
+// 検証エラーを含む場合は検証エラーを優先し（メッセージは trans の言語）、Error・echo.HTTPError 以外は内部エラーとして扱います
+func From(err error, trans ut.Translator) *Error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return Validation(validationErrs, trans)
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		message, ok := he.Message.(string)
+		if !ok {
+			message = http.StatusText(he.Code)
+		}
+		return New(he.Code, codeForStatus(he.Code), message).Wrap(err)
+	}
+
+	return Internal(err)
+}
@@ -0,0 +1,35 @@
+package apperrors
+
+import (
+	"net/http"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/latttchc/finding-forest-backend/internal/validators"
+)
+
+// FieldError は入力項目ごとの検証エラーです
+type FieldError struct {
+	Field   string `json:"field"`           // 項目名（JSON のキー、ネストした項目は interview.stage のように表す）
+	Rule    string `json:"rule"`            // 満たさなかったルール（required・max など）
+	Param   string `json:"param,omitempty"` // ルールのパラメーター（max=100 の 100 など）
+	Message string `json:"message"`         // 表示用のメッセージ（Accept-Language の言語）
+}
+
+// Validation は validator.ValidationErrors から入力値の検証エラーを作成します
+// 項目ごとのメッセージは trans の言語に翻訳します
+func Validation(errs validator.ValidationErrors, trans ut.Translator) *Error {
+	details := make([]FieldError, len(errs))
+	for i, fe := range errs {
+		details[i] = FieldError{
+			Field:   validators.FieldPath(fe),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: validators.Translate(trans, fe),
+		}
+	}
+
+	e := New(http.StatusBadRequest, CodeValidationFailed, "Validation failed").Wrap(errs)
+	e.Details = details
+	return e
+}
@@ -4,17 +4,21 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	App      AppConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	App        AppConfig
+	Moderation ModerationConfig
 }
 
 type ServerConfig struct {
-	Port string
-	Host string
+	Port       string
+	Host       string
+	TrustProxy bool // X-Forwarded-For ヘッダーの接続元IPアドレスを信頼するかどうか（リバースプロキシの背後で動かす場合）
 }
 
 type DatabaseConfig struct {
@@ -24,11 +28,22 @@ type DatabaseConfig struct {
 	Password string
 	Name     string
 	SSLMode  string
+
+	AutoMigrate bool // 起動時に未適用のマイグレーションを適用するかどうか
 }
 
 type AppConfig struct {
-	Environment string
-	LogLevel    string
+	Environment            string
+	LogLevel               string
+	SuggestRefreshInterval time.Duration // 企業名補完インデックスの再構築間隔
+}
+
+type ModerationConfig struct {
+	ReportHideThreshold int           // この件数の未対応通報が集まると自動的に非表示にする
+	SessionTTL          time.Duration // モデレーターのセッション有効期間
+	InitialUsername     string        // 起動時に作成する初期モデレーターのユーザー名
+	InitialPassword     string        // 起動時に作成する初期モデレーターのパスワード
+	NGWords             []string      // 投稿・コメントに使用できない語句
 }
 
 func Load() *Config {
@@ -37,6 +52,8 @@ func Load() *Config {
 		Server: ServerConfig{
 			Port: getEnv("PORT", "8080"),
 			Host: getEnv("HOST", "0.0.0.0"),
+
+			TrustProxy: getEnvAsBool("TRUST_PROXY", false),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -45,10 +62,20 @@ func Load() *Config {
 			Password: getEnv("DB_PASSWORD", "password"),
 			Name:     getEnv("DB_NAME", "jobboard"),
 			SSLMode:  getEnv("DB_SSLMODE", "require"),
+
+			AutoMigrate: getEnvAsBool("DB_AUTO_MIGRATE", true),
 		},
 		App: AppConfig{
-			Environment: getEnv("ENVIRONMENT", "development"),
-			LogLevel:    getEnv("LOG_LEVEL", "info"),
+			Environment:            getEnv("ENVIRONMENT", "development"),
+			LogLevel:               getEnv("LOG_LEVEL", "info"),
+			SuggestRefreshInterval: time.Duration(getEnvAsInt("SUGGEST_REFRESH_MINUTES", 10)) * time.Minute,
+		},
+		Moderation: ModerationConfig{
+			ReportHideThreshold: getEnvAsInt("REPORT_HIDE_THRESHOLD", 3),
+			SessionTTL:          time.Duration(getEnvAsInt("MODERATOR_SESSION_TTL_HOURS", 24)) * time.Hour,
+			InitialUsername:     getEnv("MODERATOR_USERNAME", ""),
+			InitialPassword:     getEnv("MODERATOR_PASSWORD", ""),
+			NGWords:             getEnvAsList("NG_WORDS"),
 		},
 	}
 
@@ -78,6 +105,27 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+		log.Printf("Warning: invalid boolean value for %s: %s", key, value)
+	}
+	return defaultValue
+}
+
+// getEnvAsList はカンマ区切りの環境変数を空の要素を除いて返します
+func getEnvAsList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func (c *Config) GetDSN() string {
 	return "host=" + c.Database.Host +
 		" port=" + strconv.Itoa(c.Database.Port) +
@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// コンテキストに保存する認証情報のキー
+const (
+	moderatorContextKey    = "moderator"
+	sessionTokenContextKey = "session_token"
+)
+
+// errModeratorSessionRequired はモデレーターのセッションが無い・無効な場合のエラーです
+var errModeratorSessionRequired = apperrors.Unauthorized("Moderator session is required")
+
+// AdminHandler はモデレーター向け管理APIのHTTPリクエストを処理するハンドラーです
+type AdminHandler struct {
+	authService       services.AuthService
+	moderationService services.ModerationService
+	reportService     services.ReportService
+}
+
+// NewAdminHandler は新しい AdminHandler インスタンスを作成します
+func NewAdminHandler(authService services.AuthService, moderationService services.ModerationService, reportService services.ReportService) *AdminHandler {
+	return &AdminHandler{
+		authService:       authService,
+		moderationService: moderationService,
+		reportService:     reportService,
+	}
+}
+
+// RequireModerator はセッショントークン（Authorization: Bearer <token>）を検証するミドルウェアです
+// 認証に成功したモデレーターはコンテキストに保存されます
+func (h *AdminHandler) RequireModerator() echo.MiddlewareFunc {
+	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		Validator: func(token string, c echo.Context) (bool, error) {
+			moderator, err := h.authService.Authenticate(token)
+			if err != nil {
+				if errors.Is(err, services.ErrInvalidSession) {
+					return false, nil
+				}
+				return false, serviceError(err)
+			}
+			c.Set(moderatorContextKey, moderator)
+			c.Set(sessionTokenContextKey, token)
+			return true, nil
+		},
+		// トークンが無い・無効な場合は 401 とする（セッションの検証中に発生したエラーはそのまま返す）
+		ErrorHandler: func(err error, c echo.Context) error {
+			var appErr *apperrors.Error
+			if errors.As(err, &appErr) {
+				return err
+			}
+			return errModeratorSessionRequired.Wrap(err)
+		},
+	})
+}
+
+// currentModerator は認証済みのモデレーターを返します
+func currentModerator(c echo.Context) *models.Moderator {
+	moderator, _ := c.Get(moderatorContextKey).(*models.Moderator)
+	return moderator
+}
+
+// Login はモデレーターのログインを行うHTTPハンドラーです
+// POST /admin/login
+func (h *AdminHandler) Login(c echo.Context) error {
+	var req models.ModeratorLoginRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.authService.Login(&req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// Logout はモデレーターのセッションを無効化するHTTPハンドラーです
+// POST /admin/logout
+func (h *AdminHandler) Logout(c echo.Context) error {
+	token, _ := c.Get(sessionTokenContextKey).(string)
+
+	if err := h.authService.Logout(currentModerator(c).ID, token); err != nil {
+		return serviceError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetReports は通報一覧（モデレーションキュー）を取得するHTTPハンドラーです
+// GET /admin/reports?status=open&page=1&limit=20
+func (h *AdminHandler) GetReports(c echo.Context) error {
+	page, limit := pageParams(c)
+
+	// サービス層を呼び出し
+	response, err := h.reportService.GetReports(page, limit, c.QueryParam("status"))
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ResolveReport は通報に対応するHTTPハンドラーです
+// POST /admin/reports/:id/resolve
+func (h *AdminHandler) ResolveReport(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid report ID")
+	}
+
+	var req models.ReportResolveRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.moderationService.ResolveReport(currentModerator(c).ID, uint(id), &req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// HidePost は投稿を非表示にするHTTPハンドラーです
+// POST /admin/posts/:id/hide
+func (h *AdminHandler) HidePost(c echo.Context) error {
+	return h.moderate(c, func(moderatorID, id uint, req *models.ModerationActionRequest) error {
+		return h.moderationService.SetPostHidden(moderatorID, id, true, req)
+	})
+}
+
+// UnhidePost は非表示の投稿を表示に戻すHTTPハンドラーです
+// POST /admin/posts/:id/unhide
+func (h *AdminHandler) UnhidePost(c echo.Context) error {
+	return h.moderate(c, func(moderatorID, id uint, req *models.ModerationActionRequest) error {
+		return h.moderationService.SetPostHidden(moderatorID, id, false, req)
+	})
+}
+
+// DeletePost は投稿を物理削除するHTTPハンドラーです
+// DELETE /admin/posts/:id
+func (h *AdminHandler) DeletePost(c echo.Context) error {
+	return h.moderate(c, h.moderationService.DeletePost)
+}
+
+// HideComment はコメントを非表示にするHTTPハンドラーです
+// POST /admin/comments/:id/hide
+func (h *AdminHandler) HideComment(c echo.Context) error {
+	return h.moderate(c, func(moderatorID, id uint, req *models.ModerationActionRequest) error {
+		return h.moderationService.SetCommentHidden(moderatorID, id, true, req)
+	})
+}
+
+// UnhideComment は非表示のコメントを表示に戻すHTTPハンドラーです
+// POST /admin/comments/:id/unhide
+func (h *AdminHandler) UnhideComment(c echo.Context) error {
+	return h.moderate(c, func(moderatorID, id uint, req *models.ModerationActionRequest) error {
+		return h.moderationService.SetCommentHidden(moderatorID, id, false, req)
+	})
+}
+
+// DeleteComment はコメントを物理削除するHTTPハンドラーです
+// DELETE /admin/comments/:id
+func (h *AdminHandler) DeleteComment(c echo.Context) error {
+	return h.moderate(c, h.moderationService.DeleteComment)
+}
+
+// GetAuditLogs は監査ログの一覧を取得するHTTPハンドラーです
+// GET /admin/audit-logs?moderator_id=1&action=hide_post&page=1&limit=20
+func (h *AdminHandler) GetAuditLogs(c echo.Context) error {
+	page, limit := pageParams(c)
+
+	var moderatorID uint
+	if idStr := c.QueryParam("moderator_id"); idStr != "" {
+		id, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			return apperrors.BadRequest("Invalid moderator ID")
+		}
+		moderatorID = uint(id)
+	}
+
+	// サービス層を呼び出し
+	response, err := h.moderationService.GetAuditLogs(page, limit, moderatorID, c.QueryParam("action"))
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// moderationFunc は投稿・コメントへの管理操作を行うサービスメソッドの型です
+type moderationFunc func(moderatorID, id uint, req *models.ModerationActionRequest) error
+
+// moderate は投稿・コメントへの管理操作に共通する処理を行います
+func (h *AdminHandler) moderate(c echo.Context, fn moderationFunc) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid target ID")
+	}
+
+	var req models.ModerationActionRequest
+
+	// リクエストボディをバインド（理由の指定は任意）
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	if err := fn(currentModerator(c).ID, uint(id), &req); err != nil {
+		return serviceError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// pageParams はクエリパラメータからページ番号と件数を取得します
+func pageParams(c echo.Context) (int, int) {
+	page := 1
+	limit := 20
+
+	if pageStr := c.QueryParam("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	return page, limit
+}
+
+// UpdateCompany は企業の正式名・読み・別名を更新するHTTPハンドラーです
+// PUT /admin/companies/:id
+func (h *AdminHandler) UpdateCompany(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid company ID")
+	}
+
+	var req models.CompanyUpdateRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.moderationService.UpdateCompany(currentModerator(c).ID, uint(id), &req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// MergeCompanies は表記ゆれで分かれた企業を統合するHTTPハンドラーです
+// POST /admin/companies/:id/merge
+func (h *AdminHandler) MergeCompanies(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid company ID")
+	}
+
+	var req models.CompanyMergeRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.moderationService.MergeCompanies(currentModerator(c).ID, uint(id), &req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// CategoryHandler はカテゴリに関するHTTPリクエストを処理するハンドラーです
+type CategoryHandler struct {
+	categoryService services.CategoryService
+}
+
+// NewCategoryHandler は新しい CategoryHandler インスタンスを作成します
+func NewCategoryHandler(categoryService services.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+// GetCategories は投稿で選択できるカテゴリ一覧を取得するHTTPハンドラーです
+// GET /api/categories
+func (h *CategoryHandler) GetCategories(c echo.Context) error {
+	// サービス層を呼び出し
+	response, err := h.categoryService.GetCategories()
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"categories": response,
+	})
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import "github.com/labstack/echo/v4"
+
+// clientIDHeader は匿名クライアント識別子を受け取るリクエストヘッダー名です
+// フロントエンドが生成してローカルに保持するランダムなIDを想定しています
+const clientIDHeader = "X-Client-ID"
+
+// maxClientIDLength はクライアント識別子として受け付ける最大長です
+const maxClientIDLength = 128
+
+// clientIdentity はリクエスト元の匿名クライアント識別子を返します
+// X-Client-ID ヘッダーが無い場合は接続元IPアドレスを使用します
+func clientIdentity(c echo.Context) string {
+	if id := c.Request().Header.Get(clientIDHeader); id != "" && len(id) <= maxClientIDLength {
+		return "client:" + id
+	}
+	return "ip:" + c.RealIP()
+}
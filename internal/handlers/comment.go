@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
 	"github.com/latttchc/finding-forest-backend/internal/models"
 	"github.com/latttchc/finding-forest-backend/internal/services"
 )
@@ -28,43 +29,120 @@ func (h *CommentHandler) CreateComment(c echo.Context) error {
 
 	// リクエストボディをバインド
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
 	}
 
 	// サービス層を呼び出し
 	response, err := h.commentService.CreateComment(&req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return serviceError(err)
 	}
 
 	return c.JSON(http.StatusCreated, response)
 }
 
 // GetCommentsByPostID は指定された投稿のコメント一覧を取得するHTTPハンドラーです
-// GET /api/posts/:post_id/comments
+// GET /api/posts/:post_id/comments?sort=newest&limit=20&cursor=<next_cursor>
 func (h *CommentHandler) GetCommentsByPostID(c echo.Context) error {
 	// パスパラメータからpost_idを取得
 	postIDStr := c.Param("post_id")
 	postID, err := strconv.ParseUint(postIDStr, 10, 32)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid post ID",
-		})
+		return apperrors.BadRequest("Invalid post ID")
+	}
+
+	// 並び順を取得
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = models.CommentSortNewest
+	}
+	if !models.IsValidCommentSort(sort) {
+		return errInvalidSort
+	}
+
+	// 件数・カーソルを取得
+	limit := 0
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+	cursor, err := cursorParam(c, sort)
+	if err != nil {
+		return errInvalidCursor.Wrap(err)
 	}
 
 	// サービス層を呼び出し
-	response, err := h.commentService.GetCommentsByPostID(uint(postID))
+	response, err := h.commentService.GetCommentsByPostID(uint(postID), sort, limit, cursor)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateComment はコメントを更新するHTTPハンドラーです
+// PUT /api/comments/:id （X-Edit-Token ヘッダーに作成時のトークンを指定）
+func (h *CommentHandler) UpdateComment(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid comment ID")
+	}
+
+	// 編集用トークンを取得
+	editToken := c.Request().Header.Get(editTokenHeader)
+	if editToken == "" {
+		return errEditTokenRequired
+	}
+
+	var req models.CommentUpdateRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.commentService.UpdateComment(uint(id), editToken, &req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteComment はコメントを削除するHTTPハンドラーです
+// DELETE /api/comments/:id （X-Edit-Token ヘッダーに作成時のトークンを指定）
+func (h *CommentHandler) DeleteComment(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid comment ID")
+	}
+
+	// 編集用トークンを取得
+	editToken := c.Request().Header.Get(editTokenHeader)
+	if editToken == "" {
+		return errEditTokenRequired
+	}
+
+	// サービス層を呼び出し
+	if err := h.commentService.DeleteComment(uint(id), editToken); err != nil {
+		return serviceError(err)
 	}
 
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"comments": response,
-	})
+	return c.NoContent(http.StatusNoContent)
 }
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// CompanyHandler は企業に関するHTTPリクエストを処理するハンドラーです
+type CompanyHandler struct {
+	companyService services.CompanyService
+	postService    services.PostService
+}
+
+// NewCompanyHandler は新しい CompanyHandler インスタンスを作成します
+func NewCompanyHandler(companyService services.CompanyService, postService services.PostService) *CompanyHandler {
+	return &CompanyHandler{
+		companyService: companyService,
+		postService:    postService,
+	}
+}
+
+// GetCompanies は企業一覧を投稿数の多い順に取得するHTTPハンドラーです
+// GET /api/companies?q=google&page=1&limit=20
+func (h *CompanyHandler) GetCompanies(c echo.Context) error {
+	page, limit := pageParams(c)
+
+	// サービス層を呼び出し
+	response, err := h.companyService.GetCompanies(page, limit, c.QueryParam("q"))
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// SuggestCompanies は入力途中の企業名から候補を返すHTTPハンドラーです
+// GET /api/companies/suggest?q=ぐー&limit=10
+func (h *CompanyHandler) SuggestCompanies(c echo.Context) error {
+	limit := 10
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 20 {
+			limit = l
+		}
+	}
+
+	// サービス層を呼び出し
+	response := h.companyService.SuggestCompanies(c.QueryParam("q"), limit)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"suggestions": response,
+	})
+}
+
+// GetCompany は指定されたIDの企業を取得するHTTPハンドラーです
+// GET /api/companies/:id
+func (h *CompanyHandler) GetCompany(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid company ID")
+	}
+
+	// サービス層を呼び出し
+	response, err := h.companyService.GetCompany(uint(id))
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetCompanyPosts は指定された企業の投稿一覧を取得するHTTPハンドラーです
+// GET /api/companies/:id/posts?page=1&limit=20 （cursor も指定可能）
+func (h *CompanyHandler) GetCompanyPosts(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid company ID")
+	}
+
+	page, limit := pageParams(c)
+	cursor, err := cursorParam(c, models.PostSortNewest)
+	if err != nil {
+		return errInvalidCursor.Wrap(err)
+	}
+
+	// サービス層を呼び出し
+	response, err := h.postService.GetPostsByCompany(uint(id), page, limit, cursor)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
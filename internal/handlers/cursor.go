@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
+)
+
+// cursorParam はクエリパラメータ cursor をデコードします
+// 指定されていない場合は nil を返し、sort と異なる並び順で発行されたカーソルはエラーとします
+func cursorParam(c echo.Context, sort string) (*pagination.Cursor, error) {
+	cursorStr := c.QueryParam("cursor")
+	if cursorStr == "" {
+		return nil, nil
+	}
+	return pagination.Decode(cursorStr, sort)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// serviceErrors はサービス層のエラーと、クライアントに返すエラーの対応です
+// メッセージはサービス層のエラーのものをそのまま使用します
+var serviceErrors = []struct {
+	err error
+	new func(message string) *apperrors.Error
+}{
+	{services.ErrValidation, apperrors.ValidationFailed},
+	{services.ErrPostNotFound, apperrors.NotFound},
+	{services.ErrCommentNotFound, apperrors.NotFound},
+	{services.ErrCompanyNotFound, apperrors.NotFound},
+	{services.ErrReportNotFound, apperrors.NotFound},
+	{services.ErrInvalidEditToken, apperrors.Forbidden},
+	{services.ErrInterviewNotAllowed, apperrors.BadRequest},
+	{services.ErrCommentDepthExceeded, apperrors.BadRequest},
+	{services.ErrParentCommentMismatch, apperrors.BadRequest},
+	{services.ErrParentCommentNotFound, apperrors.BadRequest},
+	{services.ErrInvalidReactionKind, apperrors.BadRequest},
+	{services.ErrClientIDRequired, apperrors.BadRequest},
+	{services.ErrCompanyMergeSelf, apperrors.BadRequest},
+	{services.ErrAlreadyReported, apperrors.Conflict},
+	{services.ErrReportAlreadyResolved, apperrors.Conflict},
+	{services.ErrCompanyNameConflict, apperrors.Conflict},
+	{services.ErrModeratorExists, apperrors.Conflict},
+	{services.ErrInvalidCredentials, apperrors.Unauthorized},
+	{services.ErrInvalidSession, apperrors.Unauthorized},
+}
+
+// serviceError はサービス層のエラーをクライアントに返すエラーに変換します
+// 入力値の検証エラーは apperrors のエラーハンドラーが項目ごとの内容を含めて返します
+// データベースの一時的な障害は 503、対応が定義されていないエラーは 500 として扱います
+func serviceError(err error) error {
+	for _, se := range serviceErrors {
+		if errors.Is(err, se.err) {
+			return se.new(se.err.Error()).Wrap(err)
+		}
+	}
+	if errors.Is(err, services.ErrUnavailable) {
+		return apperrors.ServiceUnavailable(err)
+	}
+	return apperrors.Internal(err)
+}
+
+// 共通のエラー
+var (
+	errInvalidRequestFormat = apperrors.BadRequest("Invalid request format")
+	errEditTokenRequired    = apperrors.Unauthorized("Edit token is required")
+	errInvalidCursor        = apperrors.BadRequest("Invalid cursor")
+	errInvalidSort          = apperrors.BadRequest("Invalid sort")
+)
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// TestServiceError はサービス層のエラーが対応するHTTPステータス・エラーコードに変換されることを確認します
+func TestServiceError(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		code   apperrors.Code
+	}{
+		{services.ErrValidation, http.StatusBadRequest, apperrors.CodeValidationFailed},
+		{services.ErrPostNotFound, http.StatusNotFound, apperrors.CodeNotFound},
+		{services.ErrCommentNotFound, http.StatusNotFound, apperrors.CodeNotFound},
+		{services.ErrCompanyNotFound, http.StatusNotFound, apperrors.CodeNotFound},
+		{services.ErrReportNotFound, http.StatusNotFound, apperrors.CodeNotFound},
+		{services.ErrInvalidEditToken, http.StatusForbidden, apperrors.CodeForbidden},
+		{services.ErrInterviewNotAllowed, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrCommentDepthExceeded, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrParentCommentMismatch, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrParentCommentNotFound, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrInvalidReactionKind, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrClientIDRequired, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrCompanyMergeSelf, http.StatusBadRequest, apperrors.CodeBadRequest},
+		{services.ErrAlreadyReported, http.StatusConflict, apperrors.CodeConflict},
+		{services.ErrReportAlreadyResolved, http.StatusConflict, apperrors.CodeConflict},
+		{services.ErrCompanyNameConflict, http.StatusConflict, apperrors.CodeConflict},
+		{services.ErrModeratorExists, http.StatusConflict, apperrors.CodeConflict},
+		{services.ErrInvalidCredentials, http.StatusUnauthorized, apperrors.CodeUnauthorized},
+		{services.ErrInvalidSession, http.StatusUnauthorized, apperrors.CodeUnauthorized},
+		{services.ErrUnavailable, http.StatusServiceUnavailable, apperrors.CodeServiceUnavailable},
+		{errors.New("unexpected"), http.StatusInternalServerError, apperrors.CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			// サービス層と同じく、原因を付け加えてラップしたエラーを渡す
+			wrapped := fmt.Errorf("operation: %w", tt.err)
+
+			var appErr *apperrors.Error
+			if !errors.As(serviceError(wrapped), &appErr) {
+				t.Fatalf("serviceError(%v) is not *apperrors.Error", wrapped)
+			}
+			if appErr.Status != tt.status || appErr.Code != tt.code {
+				t.Errorf("got %d %s, want %d %s", appErr.Status, appErr.Code, tt.status, tt.code)
+			}
+			if !errors.Is(appErr, tt.err) {
+				t.Errorf("the returned error does not wrap %v", tt.err)
+			}
+		})
+	}
+}
+
+// TestServiceErrorMessage は対応が定義されたエラーのメッセージに原因の詳細を含めないことを確認します
+func TestServiceErrorMessage(t *testing.T) {
+	err := serviceError(fmt.Errorf("get post 1: %w", services.ErrPostNotFound))
+
+	var appErr *apperrors.Error
+	if !errors.As(err, &appErr) {
+		t.Fatalf("serviceError returned %T", err)
+	}
+	if appErr.Message != services.ErrPostNotFound.Error() {
+		t.Errorf("message = %q, want %q", appErr.Message, services.ErrPostNotFound.Error())
+	}
+}
@@ -3,12 +3,17 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
 	"github.com/latttchc/finding-forest-backend/internal/models"
 	"github.com/latttchc/finding-forest-backend/internal/services"
 )
 
+// editTokenHeader は編集用トークンを受け取るリクエストヘッダー名です
+const editTokenHeader = "X-Edit-Token"
+
 // PostHandler は投稿に関するHTTPリクエストを処理するハンドラーです
 type PostHandler struct {
 	postService services.PostService
@@ -28,17 +33,18 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 
 	// リクエストボディをバインド
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
 	}
 
 	// サービス層を呼び出し
 	response, err := h.postService.CreatePost(&req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return serviceError(err)
 	}
 
 	return c.JSON(http.StatusCreated, response)
@@ -51,30 +57,34 @@ func (h *PostHandler) GetPost(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid post ID",
-		})
+		return apperrors.BadRequest("Invalid post ID")
 	}
 
 	// サービス層を呼び出し
 	response, err := h.postService.GetPost(uint(id))
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return serviceError(err)
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
 
 // GetPosts は投稿一覧を取得するHTTPハンドラーです
-// GET /api/posts?page=1&limit=20&category=面接&company_name=Google
+// GET /api/posts?page=1&limit=20&sort=newest&category=面接&category=ES&company_name=Google&q=逆質問
+// GET /api/posts?job_type=エンジニア&created_after=2024-04-01&created_before=2024-06-30&has_comments=true
+// GET /api/posts?category=面接&interview_stage=最終&interview_format=online&interview_outcome=passed
+// GET /api/posts?tag=インターン
+// GET /api/posts?cursor=<next_cursor>&limit=20&sort=newest （カーソル方式）
 func (h *PostHandler) GetPosts(c echo.Context) error {
 	// クエリパラメータを取得
 	pageStr := c.QueryParam("page")
 	limitStr := c.QueryParam("limit")
-	category := c.QueryParam("category")
-	companyName := c.QueryParam("company_name")
+
+	// 絞り込み条件を取得
+	filter, err := postFilterParams(c)
+	if err != nil {
+		return err
+	}
 
 	// ページネーション設定
 	page := 1
@@ -92,13 +102,164 @@ func (h *PostHandler) GetPosts(c echo.Context) error {
 		}
 	}
 
+	// カーソルを取得（並び順の指定が無い場合は新しい順のカーソルとする）
+	cursorSort := filter.Sort
+	if cursorSort == "" {
+		cursorSort = models.PostSortNewest
+	}
+	cursor, err := cursorParam(c, cursorSort)
+	if err != nil {
+		return errInvalidCursor.Wrap(err)
+	}
+
 	// サービス層を呼び出し
-	response, err := h.postService.GetPosts(page, limit, category, companyName)
+	response, err := h.postService.GetPosts(filter, page, limit, cursor)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return serviceError(err)
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
+
+// postFilterParams はクエリパラメータから投稿一覧の絞り込み条件を取得します
+// category は複数指定でき、日付は YYYY-MM-DD または RFC3339 形式で指定します
+func postFilterParams(c echo.Context) (models.PostFilter, error) {
+	filter := models.PostFilter{
+		CompanyName: c.QueryParam("company_name"),
+		JobType:     c.QueryParam("job_type"),
+		Keyword:     c.QueryParam("q"),
+		Tag:         c.QueryParam("tag"),
+		Sort:        c.QueryParam("sort"),
+	}
+
+	// 並び順を検証
+	if filter.Sort != "" && !models.IsValidPostSort(filter.Sort) {
+		return filter, errInvalidSort
+	}
+
+	// カテゴリ（空の値は無視）
+	for _, category := range c.QueryParams()["category"] {
+		if category != "" {
+			filter.Categories = append(filter.Categories, category)
+		}
+	}
+
+	// 作成日時の範囲
+	if value := c.QueryParam("created_after"); value != "" {
+		t, _, err := parseDateParam(value)
+		if err != nil {
+			return filter, apperrors.BadRequest("Invalid created_after")
+		}
+		filter.CreatedAfter = &t
+	}
+	if value := c.QueryParam("created_before"); value != "" {
+		t, dateOnly, err := parseDateParam(value)
+		if err != nil {
+			return filter, apperrors.BadRequest("Invalid created_before")
+		}
+		// 日付のみの場合はその日を含める
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		filter.CreatedBefore = &t
+	}
+
+	// コメントの有無
+	if value := c.QueryParam("has_comments"); value != "" {
+		hasComments, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, apperrors.BadRequest("Invalid has_comments")
+		}
+		filter.HasComments = &hasComments
+	}
+
+	// 面接情報
+	filter.InterviewStage = c.QueryParam("interview_stage")
+	if filter.InterviewStage != "" && !models.IsValidInterviewStage(filter.InterviewStage) {
+		return filter, apperrors.BadRequest("Invalid interview_stage")
+	}
+	filter.InterviewFormat = c.QueryParam("interview_format")
+	if filter.InterviewFormat != "" && !models.IsValidInterviewFormat(filter.InterviewFormat) {
+		return filter, apperrors.BadRequest("Invalid interview_format")
+	}
+	filter.InterviewOutcome = c.QueryParam("interview_outcome")
+	if filter.InterviewOutcome != "" && !models.IsValidInterviewOutcome(filter.InterviewOutcome) {
+		return filter, apperrors.BadRequest("Invalid interview_outcome")
+	}
+
+	return filter, nil
+}
+
+// dateParamLocation は日付のみのパラメータを解釈するタイムゾーンです
+var dateParamLocation = time.FixedZone("JST", 9*60*60)
+
+// parseDateParam は YYYY-MM-DD（日本時間の0時）または RFC3339 形式の日時を解析します
+// 日付のみの形式だった場合は2番目の戻り値が true になります
+func parseDateParam(value string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, dateParamLocation); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	return t, false, err
+}
+
+// UpdatePost は投稿を更新するHTTPハンドラーです
+// PUT /api/posts/:id （X-Edit-Token ヘッダーに作成時のトークンを指定）
+func (h *PostHandler) UpdatePost(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid post ID")
+	}
+
+	// 編集用トークンを取得
+	editToken := c.Request().Header.Get(editTokenHeader)
+	if editToken == "" {
+		return errEditTokenRequired
+	}
+
+	var req models.PostUpdateRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.postService.UpdatePost(uint(id), editToken, &req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeletePost は投稿を削除するHTTPハンドラーです
+// DELETE /api/posts/:id （X-Edit-Token ヘッダーに作成時のトークンを指定）
+func (h *PostHandler) DeletePost(c echo.Context) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid post ID")
+	}
+
+	// 編集用トークンを取得
+	editToken := c.Request().Header.Get(editTokenHeader)
+	if editToken == "" {
+		return errEditTokenRequired
+	}
+
+	// サービス層を呼び出し
+	if err := h.postService.DeletePost(uint(id), editToken); err != nil {
+		return serviceError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
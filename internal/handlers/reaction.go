@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// ReactionHandler はリアクションに関するHTTPリクエストを処理するハンドラーです
+type ReactionHandler struct {
+	reactionService services.ReactionService
+}
+
+// NewReactionHandler は新しい ReactionHandler インスタンスを作成します
+func NewReactionHandler(reactionService services.ReactionService) *ReactionHandler {
+	return &ReactionHandler{
+		reactionService: reactionService,
+	}
+}
+
+// AddPostReaction は投稿にリアクションを付けるHTTPハンドラーです
+// PUT /api/posts/:id/reactions/:kind
+func (h *ReactionHandler) AddPostReaction(c echo.Context) error {
+	return h.handle(c, models.ReactionTargetPost, h.reactionService.AddReaction)
+}
+
+// RemovePostReaction は投稿のリアクションを取り消すHTTPハンドラーです
+// DELETE /api/posts/:id/reactions/:kind
+func (h *ReactionHandler) RemovePostReaction(c echo.Context) error {
+	return h.handle(c, models.ReactionTargetPost, h.reactionService.RemoveReaction)
+}
+
+// AddCommentReaction はコメントにリアクションを付けるHTTPハンドラーです
+// PUT /api/comments/:id/reactions/:kind
+func (h *ReactionHandler) AddCommentReaction(c echo.Context) error {
+	return h.handle(c, models.ReactionTargetComment, h.reactionService.AddReaction)
+}
+
+// RemoveCommentReaction はコメントのリアクションを取り消すHTTPハンドラーです
+// DELETE /api/comments/:id/reactions/:kind
+func (h *ReactionHandler) RemoveCommentReaction(c echo.Context) error {
+	return h.handle(c, models.ReactionTargetComment, h.reactionService.RemoveReaction)
+}
+
+// reactionFunc はリアクションの追加・取り消しを行うサービスメソッドの型です
+type reactionFunc func(targetType string, targetID uint, kind, clientID string) (*models.ReactionResponse, error)
+
+// handle はリアクションの追加・取り消しに共通する処理を行います
+func (h *ReactionHandler) handle(c echo.Context, targetType string, fn reactionFunc) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid target ID")
+	}
+
+	// サービス層を呼び出し
+	response, err := fn(targetType, uint(id), c.Param("kind"), clientIdentity(c))
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
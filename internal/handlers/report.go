@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/apperrors"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// ReportHandler は通報に関するHTTPリクエストを処理するハンドラーです
+type ReportHandler struct {
+	reportService services.ReportService
+}
+
+// NewReportHandler は新しい ReportHandler インスタンスを作成します
+func NewReportHandler(reportService services.ReportService) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// ReportPost は投稿を通報するHTTPハンドラーです
+// POST /api/posts/:id/reports
+func (h *ReportHandler) ReportPost(c echo.Context) error {
+	return h.createReport(c, models.ReportTargetPost)
+}
+
+// ReportComment はコメントを通報するHTTPハンドラーです
+// POST /api/comments/:id/reports
+func (h *ReportHandler) ReportComment(c echo.Context) error {
+	return h.createReport(c, models.ReportTargetComment)
+}
+
+// createReport は通報の作成に共通する処理を行います
+func (h *ReportHandler) createReport(c echo.Context, targetType string) error {
+	// パスパラメータからIDを取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return apperrors.BadRequest("Invalid target ID")
+	}
+
+	var req models.ReportCreateRequest
+
+	// リクエストボディをバインド
+	if err := c.Bind(&req); err != nil {
+		return errInvalidRequestFormat.Wrap(err)
+	}
+
+	// 入力値を検証
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	// サービス層を呼び出し
+	response, err := h.reportService.CreateReport(targetType, uint(id), clientIdentity(c), c.RealIP(), &req)
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
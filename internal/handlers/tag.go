@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// TagHandler はタグに関するHTTPリクエストを処理するハンドラーです
+type TagHandler struct {
+	tagService services.TagService
+}
+
+// NewTagHandler は新しい TagHandler インスタンスを作成します
+func NewTagHandler(tagService services.TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+// GetTags はタグ一覧を投稿数の多い順に取得するHTTPハンドラーです
+// GET /api/tags?q=インターン&limit=50
+func (h *TagHandler) GetTags(c echo.Context) error {
+	limit := 50
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	// サービス層を呼び出し
+	response, err := h.tagService.GetTags(limit, c.QueryParam("q"))
+	if err != nil {
+		return serviceError(err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"tags": response,
+	})
+}
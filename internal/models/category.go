@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Category は投稿のカテゴリです
+// 投稿の category には Name を保存し、無効化したカテゴリは新規投稿・更新で選択できなくなります
+type Category struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	Name         string    `json:"name" gorm:"size:20;not null;uniqueIndex"`
+	Description  string    `json:"description" gorm:"size:200"`
+	DisplayOrder int       `json:"display_order" gorm:"not null;default:0"`
+	Active       bool      `json:"active" gorm:"not null;default:true"`
+	IsInterview  bool      `json:"is_interview" gorm:"not null;default:false"` // 面接情報（PostInterview）を登録できるカテゴリ
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CategoryResponse はカテゴリレスポンスの構造体
+type CategoryResponse struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	DisplayOrder int    `json:"display_order"`
+	IsInterview  bool   `json:"is_interview"`
+}
@@ -7,27 +7,67 @@ import (
 )
 
 type Comment struct {
-	ID        uint           `json:"id" gorm:"primaryKey"`
-	PostID    uint           `json:"post_id" gorm:"not null"`
-	Content   string         `json:"content" gorm:"type:text;not null" validate:"required,min=1,max=300"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+	ID            uint           `json:"id" gorm:"primaryKey;index:idx_comments_post_id_created_at_id,priority:3;index:idx_comments_post_id_reaction_count_id,priority:3"`
+	PostID        uint           `json:"post_id" gorm:"not null;index:idx_comments_post_id_created_at_id,priority:1;index:idx_comments_post_id_reaction_count_id,priority:1"`
+	ParentID      *uint          `json:"parent_id" gorm:"index"` // 返信先コメントID（トップレベルは nil）
+	RootID        *uint          `json:"root_id" gorm:"index"`   // スレッドの起点コメントID（トップレベルは nil）
+	Depth         int            `json:"depth" gorm:"not null;default:0"`
+	Content       string         `json:"content" gorm:"type:text;not null" validate:"required,text_min=1,text_max=300,no_url_only,no_ng_word"`
+	EditTokenHash string         `json:"-" gorm:"size:64"`
+	ReactionCount int64          `json:"reaction_count" gorm:"not null;default:0;index:idx_comments_post_id_reaction_count_id,priority:2"` // リアクションの合計件数（リアクション追加・取り消し時に更新）
+	HiddenAt      *time.Time     `json:"-" gorm:"index"`                                                                                   // 通報・モデレーションにより非表示になった日時
+	CreatedAt     time.Time      `json:"created_at" gorm:"index:idx_comments_post_id_created_at_id,priority:2"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"-" gorm:"index"`
 
 	Post Post `json:"-" gorm:"foreignKey:PostID"`
 }
 
+// コメント一覧の並び順
+const (
+	CommentSortNewest    = "newest"    // 新しい順
+	CommentSortOldest    = "oldest"    // 古い順
+	CommentSortReactions = "reactions" // リアクションの多い順
+)
+
+// CommentSorts は指定可能なコメント一覧の並び順の一覧です
+var CommentSorts = []string{CommentSortNewest, CommentSortOldest, CommentSortReactions}
+
+// IsValidCommentSort はコメント一覧の並び順が有効かどうかを判定します
+func IsValidCommentSort(sort string) bool {
+	for _, s := range CommentSorts {
+		if s == sort {
+			return true
+		}
+	}
+	return false
+}
+
 // CommentCreateRequest はコメント作成リクエストの構造体
 type CommentCreateRequest struct {
-	PostID  uint   `json:"post_id" validate:"required"`
-	Content string `json:"content" validate:"required,min=1,max=300"`
+	PostID   uint   `json:"post_id" validate:"required"`
+	ParentID *uint  `json:"parent_id" validate:"omitempty,min=1"`
+	Content  string `json:"content" validate:"required,text_min=1,text_max=300,no_url_only,no_ng_word"`
+}
+
+// CommentUpdateRequest はコメント更新リクエストの構造体
+type CommentUpdateRequest struct {
+	Content string `json:"content" validate:"required,text_min=1,text_max=300,no_url_only,no_ng_word"`
 }
 
 // CommentResponse はコメントレスポンスの構造体
+// 一覧・詳細では返信が Replies にツリー状に格納される
 type CommentResponse struct {
-	ID        uint      `json:"id"`
-	PostID    uint      `json:"post_id"`
-	Content   string    `json:"content"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         uint              `json:"id"`
+	PostID     uint              `json:"post_id"`
+	ParentID   *uint             `json:"parent_id"`
+	Depth      int               `json:"depth"`
+	Content    string            `json:"content"`
+	Removed    bool              `json:"removed"` // 削除・非表示のため本文を表示しないスレッドの起点
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+	EditToken  string            `json:"edit_token,omitempty"` // 作成時のみ返される編集用トークン
+	Reactions  ReactionCounts    `json:"reactions"`
+	ReplyCount int               `json:"reply_count"`
+	Replies    []CommentResponse `json:"replies"`
 }
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// Company は投稿の対象となる企業です
+// 表記ゆれは NormalizedName と CompanyAlias で吸収します
+type Company struct {
+	ID             uint           `json:"id" gorm:"primaryKey"`
+	Name           string         `json:"name" gorm:"type:text;not null"`                // 正式な表示名
+	NormalizedName string         `json:"-" gorm:"type:text;not null;uniqueIndex"`       // 比較用に正規化した企業名
+	Kana           string         `json:"kana" gorm:"type:text"`                         // 読み（ひらがな）
+	PostCount      int64          `json:"post_count" gorm:"->;-:migration"`              // 集計クエリでのみ設定される投稿数
+	Aliases        []CompanyAlias `json:"aliases,omitempty" gorm:"foreignKey:CompanyID"` // 別名
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// CompanyAlias は企業の別名（英語表記・略称・旧社名など）です
+type CompanyAlias struct {
+	ID              uint      `json:"id" gorm:"primaryKey"`
+	CompanyID       uint      `json:"company_id" gorm:"not null;index"`
+	Alias           string    `json:"alias" gorm:"type:text;not null"`
+	NormalizedAlias string    `json:"-" gorm:"type:text;not null;uniqueIndex"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// CompanyUpdateRequest は企業情報更新リクエストの構造体
+// Aliases は指定した内容で置き換えられます
+type CompanyUpdateRequest struct {
+	Name    string   `json:"name" validate:"required,text_min=1,text_max=50"`
+	Kana    string   `json:"kana" validate:"text_max=100"`
+	Aliases []string `json:"aliases" validate:"max=20,dive,required,text_max=50"`
+}
+
+// CompanyMergeRequest は企業統合リクエストの構造体
+// SourceID の企業の投稿・別名を統合先に移し、SourceID の企業は削除されます
+type CompanyMergeRequest struct {
+	SourceID uint `json:"source_id" validate:"required"`
+}
+
+// CompanyResponse は企業レスポンスの構造体
+type CompanyResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Kana      string    `json:"kana"`
+	Aliases   []string  `json:"aliases"`
+	PostCount int64     `json:"post_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CompanySuggestion は企業名補完の候補の構造体
+type CompanySuggestion struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	Kana         string `json:"kana,omitempty"`
+	MatchedAlias string `json:"matched_alias,omitempty"` // 別名で一致した場合の別名
+	PostCount    int64  `json:"post_count"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// PostExport はエクスポートする投稿の構造体
+// 編集用トークンのハッシュなどの内部情報は含めず、非表示の状態は Hidden で表す
+type PostExport struct {
+	ID            uint                   `json:"id"`
+	Title         string                 `json:"title"`
+	Content       string                 `json:"content"`
+	Category      string                 `json:"category"`
+	CompanyName   string                 `json:"company_name"`
+	CompanyID     *uint                  `json:"company_id"`
+	JobType       string                 `json:"job_type"`
+	Interview     *PostInterviewResponse `json:"interview,omitempty"`
+	Tags          []string               `json:"tags"`
+	ReactionCount int64                  `json:"reaction_count"`
+	Hidden        bool                   `json:"hidden"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+	Comments      []CommentExport        `json:"comments"`
+}
+
+// CommentExport はエクスポートするコメントの構造体
+type CommentExport struct {
+	ID            uint      `json:"id"`
+	ParentID      *uint     `json:"parent_id"`
+	Content       string    `json:"content"`
+	ReactionCount int64     `json:"reaction_count"`
+	Hidden        bool      `json:"hidden"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
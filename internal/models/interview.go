@@ -0,0 +1,79 @@
+package models
+
+import "time"
+
+// 面接の選考段階
+const (
+	InterviewStageFirst  = "一次"
+	InterviewStageSecond = "二次"
+	InterviewStageFinal  = "最終"
+)
+
+// 面接の形式
+const (
+	InterviewFormatOnline = "online" // オンライン
+	InterviewFormatOnsite = "onsite" // 対面
+)
+
+// 面接の結果
+const (
+	InterviewOutcomePassed  = "passed"  // 通過
+	InterviewOutcomeFailed  = "failed"  // 不通過
+	InterviewOutcomePending = "pending" // 結果待ち
+)
+
+// PostInterview は面接カテゴリ（Category.IsInterview）の投稿に付随する構造化された面接情報です
+// 投稿と1対1で対応し、すべての項目は任意です
+type PostInterview struct {
+	ID               uint       `json:"id" gorm:"primaryKey"`
+	PostID           uint       `json:"post_id" gorm:"not null;uniqueIndex"`
+	Stage            string     `json:"stage" gorm:"size:10;index"`   // 選考段階（一次・二次・最終）
+	Format           string     `json:"format" gorm:"size:10;index"`  // 形式（online・onsite）
+	DurationMinutes  *int       `json:"duration_minutes"`             // 所要時間（分）
+	InterviewerCount *int       `json:"interviewer_count"`            // 面接官の人数
+	Outcome          string     `json:"outcome" gorm:"size:10;index"` // 結果（passed・failed・pending）
+	InterviewedOn    *time.Time `json:"interviewed_on" gorm:"type:date"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// PostInterviewRequest は投稿作成・更新時に指定する面接情報の構造体
+type PostInterviewRequest struct {
+	Stage            string `json:"stage" validate:"omitempty,oneof=一次 二次 最終"`
+	Format           string `json:"format" validate:"omitempty,oneof=online onsite"`
+	DurationMinutes  *int   `json:"duration_minutes" validate:"omitempty,min=1,max=600"`
+	InterviewerCount *int   `json:"interviewer_count" validate:"omitempty,min=1,max=20"`
+	Outcome          string `json:"outcome" validate:"omitempty,oneof=passed failed pending"`
+	InterviewedOn    string `json:"interviewed_on" validate:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+}
+
+// IsEmpty は面接情報の項目がすべて未指定かどうかを返す
+func (r *PostInterviewRequest) IsEmpty() bool {
+	return r.Stage == "" && r.Format == "" && r.DurationMinutes == nil &&
+		r.InterviewerCount == nil && r.Outcome == "" && r.InterviewedOn == ""
+}
+
+// PostInterviewResponse は面接情報レスポンスの構造体
+type PostInterviewResponse struct {
+	Stage            string `json:"stage,omitempty"`
+	Format           string `json:"format,omitempty"`
+	DurationMinutes  *int   `json:"duration_minutes,omitempty"`
+	InterviewerCount *int   `json:"interviewer_count,omitempty"`
+	Outcome          string `json:"outcome,omitempty"`
+	InterviewedOn    string `json:"interviewed_on,omitempty"` // YYYY-MM-DD
+}
+
+// IsValidInterviewStage は面接の選考段階が有効かどうかを判定します
+func IsValidInterviewStage(stage string) bool {
+	return stage == InterviewStageFirst || stage == InterviewStageSecond || stage == InterviewStageFinal
+}
+
+// IsValidInterviewFormat は面接の形式が有効かどうかを判定します
+func IsValidInterviewFormat(format string) bool {
+	return format == InterviewFormatOnline || format == InterviewFormatOnsite
+}
+
+// IsValidInterviewOutcome は面接の結果が有効かどうかを判定します
+func IsValidInterviewOutcome(outcome string) bool {
+	return outcome == InterviewOutcomePassed || outcome == InterviewOutcomeFailed || outcome == InterviewOutcomePending
+}
@@ -0,0 +1,97 @@
+package models
+
+import "time"
+
+// Moderator は管理APIを利用できるモデレーターのアカウントです
+type Moderator struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	Username     string     `json:"username" gorm:"size:50;not null;uniqueIndex"`
+	PasswordHash string     `json:"-" gorm:"not null"`
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// ModeratorSession はモデレーターのログインセッションです
+// トークンはハッシュ値のみを保存します
+type ModeratorSession struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	ModeratorID uint      `json:"moderator_id" gorm:"not null;index"`
+	TokenHash   string    `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt   time.Time `json:"expires_at" gorm:"not null;index"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	Moderator Moderator `json:"-" gorm:"foreignKey:ModeratorID"`
+}
+
+// 監査ログのアクション種別
+const (
+	AuditActionLogin         = "login"
+	AuditActionLogout        = "logout"
+	AuditActionHidePost      = "hide_post"
+	AuditActionUnhidePost    = "unhide_post"
+	AuditActionDeletePost    = "delete_post"
+	AuditActionHideComment   = "hide_comment"
+	AuditActionUnhideComment = "unhide_comment"
+	AuditActionDeleteComment = "delete_comment"
+	AuditActionResolveReport = "resolve_report"
+	AuditActionUpdateCompany = "update_company"
+	AuditActionMergeCompany  = "merge_company"
+)
+
+// AuditLog はモデレーターの操作履歴です
+type AuditLog struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	ModeratorID uint      `json:"moderator_id" gorm:"not null;index"`
+	Action      string    `json:"action" gorm:"size:32;not null;index"`
+	TargetType  string    `json:"target_type" gorm:"size:16"`
+	TargetID    uint      `json:"target_id"`
+	Detail      string    `json:"detail" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at" gorm:"index"`
+
+	Moderator Moderator `json:"-" gorm:"foreignKey:ModeratorID"`
+}
+
+// ModeratorCreateRequest はモデレーター作成リクエストの構造体
+type ModeratorCreateRequest struct {
+	Username string `json:"username" validate:"required,alphanum,min=3,max=50"`
+	Password string `json:"password" normalize:"-" validate:"required,min=12,max=72"`
+}
+
+// ModeratorLoginRequest はモデレーターのログインリクエストの構造体
+type ModeratorLoginRequest struct {
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" normalize:"-" validate:"required"`
+}
+
+// ModerationActionRequest はモデレーション操作の理由を受け取るリクエストの構造体
+type ModerationActionRequest struct {
+	Reason string `json:"reason" validate:"text_max=500"`
+}
+
+// ModeratorResponse はモデレーターレスポンスの構造体
+type ModeratorResponse struct {
+	ID          uint       `json:"id"`
+	Username    string     `json:"username"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ModeratorLoginResponse はログインレスポンスの構造体
+type ModeratorLoginResponse struct {
+	Token     string            `json:"token"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	Moderator ModeratorResponse `json:"moderator"`
+}
+
+// AuditLogResponse は監査ログレスポンスの構造体
+type AuditLogResponse struct {
+	ID                uint      `json:"id"`
+	ModeratorID       uint      `json:"moderator_id"`
+	ModeratorUsername string    `json:"moderator_username"`
+	Action            string    `json:"action"`
+	TargetType        string    `json:"target_type,omitempty"`
+	TargetID          uint      `json:"target_id,omitempty"`
+	Detail            string    `json:"detail,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
@@ -7,60 +7,143 @@ import (
 )
 
 type Post struct {
-	ID          uint           `json:"id" gorm:"primaryKey"`
-	Title       string         `json:"title" gorm:"not null" validate:"required,min=1,max=100"`
-	Content     string         `json:"content" gorm:"type:text;not null" validate:"required,min=1,max=2000"`
-	Category    string         `json:"category" gorm:"not null" validate:"required,oneof=面接 ES 企業情報 その他"`
-	CompanyName string         `json:"company_name" gorm:"not null" validate:"required,min=1,max=50"`
-	JobType     string         `json:"job_type" validate:"max=30"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
-
-	Comments []Comment `json:"comments,omitempty" gorm:"foreignKey:PostID"`
+	ID            uint           `json:"id" gorm:"primaryKey;index:idx_posts_created_at_id,priority:2;index:idx_posts_bumped_at_id,priority:2;index:idx_posts_comment_count_id,priority:2;index:idx_posts_reaction_count_id,priority:2"`
+	Title         string         `json:"title" gorm:"not null" validate:"required,text_min=1,text_max=100,no_ng_word"`
+	Content       string         `json:"content" gorm:"type:text;not null" validate:"required,text_min=1,text_max=2000,no_url_only,no_ng_word"`
+	Category      string         `json:"category" gorm:"not null" validate:"required,category"` // categories テーブルのカテゴリ名
+	CompanyName   string         `json:"company_name" gorm:"not null" validate:"required,text_min=1,text_max=50,no_ng_word"`
+	CompanyID     *uint          `json:"company_id" gorm:"index"`
+	JobType       string         `json:"job_type" validate:"text_max=30"`
+	EditTokenHash string         `json:"-" gorm:"size:64"`
+	CommentCount  int64          `json:"comment_count" gorm:"not null;default:0;index:idx_posts_comment_count_id,priority:1"`   // 公開中のコメント数（コメント作成・削除時に更新）
+	ReactionCount int64          `json:"reaction_count" gorm:"not null;default:0;index:idx_posts_reaction_count_id,priority:1"` // リアクションの合計件数（リアクション追加・取り消し時に更新）
+	BumpedAt      time.Time      `json:"bumped_at" gorm:"index:idx_posts_bumped_at_id,priority:1"`                              // 最後にコメントされた日時（コメントが無い場合は作成日時）
+	HiddenAt      *time.Time     `json:"-" gorm:"index"`                                                                        // 通報・モデレーションにより非表示になった日時
+	SearchText    string         `json:"-" gorm:"type:text"`                                                                    // 全文検索用のバイグラムトークン（スペース区切り）
+	CreatedAt     time.Time      `json:"created_at" gorm:"index:idx_posts_created_at_id,priority:1"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"-" gorm:"index"`
+
+	Company   *Company       `json:"-" gorm:"foreignKey:CompanyID"`
+	Interview *PostInterview `json:"interview,omitempty" gorm:"foreignKey:PostID"` // 面接カテゴリのみ
+	Tags      []Tag          `json:"tags,omitempty" gorm:"many2many:post_tags;"`
+	Comments  []Comment      `json:"comments,omitempty" gorm:"foreignKey:PostID"`
+}
+
+// 投稿一覧の並び順
+const (
+	PostSortNewest    = "newest"    // 新しい順
+	PostSortOldest    = "oldest"    // 古い順
+	PostSortComments  = "comments"  // コメントの多い順
+	PostSortBumped    = "bumped"    // 最近コメントされた順
+	PostSortReactions = "reactions" // リアクションの多い順
+)
+
+// PostSorts は指定可能な投稿一覧の並び順の一覧です
+var PostSorts = []string{PostSortNewest, PostSortOldest, PostSortComments, PostSortBumped, PostSortReactions}
+
+// IsValidPostSort は投稿一覧の並び順が有効かどうかを判定します
+func IsValidPostSort(sort string) bool {
+	for _, s := range PostSorts {
+		if s == sort {
+			return true
+		}
+	}
+	return false
+}
+
+// PostFilter は投稿一覧の絞り込み条件と並び順を表す構造体
+// ゼロ値の項目は絞り込みに使用しない
+type PostFilter struct {
+	Categories    []string   // カテゴリ（いずれかに一致）
+	CompanyName   string     // 企業名（部分一致）
+	CompanyID     uint       // 企業ID
+	JobType       string     // 職種（部分一致）
+	Keyword       string     // タイトル・本文の全文検索キーワード
+	CreatedAfter  *time.Time // この日時以降に作成された投稿
+	CreatedBefore *time.Time // この日時より前に作成された投稿
+	HasComments   *bool      // コメントの有無
+
+	// 面接情報による絞り込み（指定した場合は面接情報が登録された投稿のみ）
+	InterviewStage   string // 選考段階
+	InterviewFormat  string // 形式
+	InterviewOutcome string // 結果
+
+	Tag string // タグ（表記ゆれを吸収して一致）
+
+	Sort string // 並び順（未指定の場合は新しい順、キーワード検索時は関連度順）
 }
 
 // PostCreateRequest は投稿作成リクエストの構造体
 type PostCreateRequest struct {
-	Title       string `json:"title" validate:"required,min=1,max=100"`
-	Content     string `json:"content" validate:"required,min=1,max=2000"`
-	Category    string `json:"category" validate:"required,oneof=面接 ES 企業情報 その他"`
-	CompanyName string `json:"company_name" validate:"required,min=1,max=50"`
-	JobType     string `json:"job_type" validate:"max=30"`
+	Title       string                `json:"title" validate:"required,text_min=1,text_max=100,no_ng_word"`
+	Content     string                `json:"content" validate:"required,text_min=1,text_max=2000,no_url_only,no_ng_word"`
+	Category    string                `json:"category" validate:"required,category"`
+	CompanyName string                `json:"company_name" validate:"required,text_min=1,text_max=50,no_ng_word"`
+	JobType     string                `json:"job_type" validate:"text_max=30"`
+	Interview   *PostInterviewRequest `json:"interview"` // 面接情報（カテゴリが面接の場合のみ指定可能）
+	Tags        []string              `json:"tags" validate:"max=10,dive,required,text_max=30,no_ng_word"`
+}
+
+// PostUpdateRequest は投稿更新リクエストの構造体
+type PostUpdateRequest struct {
+	Title       string                `json:"title" validate:"required,text_min=1,text_max=100,no_ng_word"`
+	Content     string                `json:"content" validate:"required,text_min=1,text_max=2000,no_url_only,no_ng_word"`
+	Category    string                `json:"category" validate:"required,category"`
+	CompanyName string                `json:"company_name" validate:"required,text_min=1,text_max=50,no_ng_word"`
+	JobType     string                `json:"job_type" validate:"text_max=30"`
+	Interview   *PostInterviewRequest `json:"interview"` // 面接情報（カテゴリが面接の場合のみ指定可能）
+	Tags        []string              `json:"tags" validate:"max=10,dive,required,text_max=30,no_ng_word"`
 }
 
 // PostResponse は投稿レスポンスの構造体
 type PostResponse struct {
-	ID          uint      `json:"id"`
-	Title       string    `json:"title"`
-	Content     string    `json:"content"`
-	Category    string    `json:"category"`
-	CompanyName string    `json:"company_name"`
-	JobType     string    `json:"job_type"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uint                   `json:"id"`
+	Title       string                 `json:"title"`
+	Content     string                 `json:"content"`
+	Category    string                 `json:"category"`
+	CompanyName string                 `json:"company_name"`
+	CompanyID   *uint                  `json:"company_id"`
+	JobType     string                 `json:"job_type"`
+	Interview   *PostInterviewResponse `json:"interview,omitempty"`
+	Tags        []string               `json:"tags"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+	EditToken   string                 `json:"edit_token,omitempty"` // 作成時のみ返される編集用トークン
 }
 
 // PostListResponse は投稿一覧レスポンスの構造体
 type PostListResponse struct {
-	ID           uint      `json:"id"`
-	Title        string    `json:"title"`
-	Category     string    `json:"category"`
-	CompanyName  string    `json:"company_name"`
-	JobType      string    `json:"job_type"`
-	CreatedAt    time.Time `json:"created_at"`
-	CommentCount int64     `json:"comment_count"`
+	ID           uint                   `json:"id"`
+	Title        string                 `json:"title"`
+	Category     string                 `json:"category"`
+	CompanyName  string                 `json:"company_name"`
+	CompanyID    *uint                  `json:"company_id"`
+	JobType      string                 `json:"job_type"`
+	Interview    *PostInterviewResponse `json:"interview,omitempty"`
+	Tags         []string               `json:"tags"`
+	CreatedAt    time.Time              `json:"created_at"`
+	BumpedAt     time.Time              `json:"bumped_at"`
+	CommentCount int64                  `json:"comment_count"`
+	Reactions    ReactionCounts         `json:"reactions"`
+	Snippet      string                 `json:"snippet,omitempty"` // 検索時のみ: マッチ箇所を <mark> で囲んだ本文の抜粋
 }
 
 // PostDetailResponse は投稿詳細レスポンスの構造体
+// コメントは先頭の数スレッドのみを含み、すべてのコメントはコメント一覧APIで取得する
 type PostDetailResponse struct {
-	ID          uint              `json:"id"`
-	Title       string            `json:"title"`
-	Content     string            `json:"content"`
-	Category    string            `json:"category"`
-	CompanyName string            `json:"company_name"`
-	JobType     string            `json:"job_type"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
-	Comments    []CommentResponse `json:"comments"`
+	ID              uint                   `json:"id"`
+	Title           string                 `json:"title"`
+	Content         string                 `json:"content"`
+	Category        string                 `json:"category"`
+	CompanyName     string                 `json:"company_name"`
+	CompanyID       *uint                  `json:"company_id"`
+	JobType         string                 `json:"job_type"`
+	Interview       *PostInterviewResponse `json:"interview,omitempty"`
+	Tags            []string               `json:"tags"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+	Reactions       ReactionCounts         `json:"reactions"`
+	CommentCount    int64                  `json:"comment_count"`    // 公開中のコメントの総数
+	CommentsPreview []CommentResponse      `json:"comments_preview"` // 新しい順の先頭スレッド（返信を含む）
 }
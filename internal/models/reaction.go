@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// リアクションの対象種別
+const (
+	ReactionTargetPost    = "post"
+	ReactionTargetComment = "comment"
+)
+
+// リアクションの種類
+const (
+	ReactionHelpful   = "helpful"   // 参考になった
+	ReactionEmpathize = "empathize" // 共感した
+	ReactionThanks    = "thanks"    // ありがとう
+)
+
+// ReactionKinds は利用可能なリアクションの種類の一覧です
+var ReactionKinds = []string{ReactionHelpful, ReactionEmpathize, ReactionThanks}
+
+// IsValidReactionKind はリアクションの種類が有効かどうかを判定します
+func IsValidReactionKind(kind string) bool {
+	for _, k := range ReactionKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Reaction は投稿・コメントに付けられたリアクションです
+// 匿名クライアントごとに対象・種類の組み合わせで一意となります
+type Reaction struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	TargetType string    `json:"target_type" gorm:"size:16;not null;uniqueIndex:idx_reactions_unique,priority:1;index:idx_reactions_target,priority:1"`
+	TargetID   uint      `json:"target_id" gorm:"not null;uniqueIndex:idx_reactions_unique,priority:2;index:idx_reactions_target,priority:2"`
+	Kind       string    `json:"kind" gorm:"size:16;not null;uniqueIndex:idx_reactions_unique,priority:3"`
+	ClientHash string    `json:"-" gorm:"size:64;not null;uniqueIndex:idx_reactions_unique,priority:4"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ReactionCounts はリアクションの種類ごとの件数です
+type ReactionCounts struct {
+	Helpful   int64 `json:"helpful"`
+	Empathize int64 `json:"empathize"`
+	Thanks    int64 `json:"thanks"`
+}
+
+// Add は指定された種類の件数を加算します
+func (c *ReactionCounts) Add(kind string, n int64) {
+	switch kind {
+	case ReactionHelpful:
+		c.Helpful += n
+	case ReactionEmpathize:
+		c.Empathize += n
+	case ReactionThanks:
+		c.Thanks += n
+	}
+}
+
+// ReactionResponse はリアクション追加・削除レスポンスの構造体
+type ReactionResponse struct {
+	TargetType string         `json:"target_type"`
+	TargetID   uint           `json:"target_id"`
+	Reactions  ReactionCounts `json:"reactions"`
+	Reacted    []string       `json:"reacted"` // リクエスト元クライアントが付けているリアクション
+}
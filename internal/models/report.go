@@ -0,0 +1,73 @@
+package models
+
+import "time"
+
+// 通報の対象種別
+const (
+	ReportTargetPost    = "post"
+	ReportTargetComment = "comment"
+)
+
+// 通報理由
+const (
+	ReportReasonPersonalInfo  = "personal_info" // 個人情報の掲載
+	ReportReasonSpam          = "spam"          // スパム・宣伝
+	ReportReasonHarassment    = "harassment"    // 誹謗中傷
+	ReportReasonInappropriate = "inappropriate" // 不適切な内容
+	ReportReasonOther         = "other"         // その他
+)
+
+// 通報の対応状況
+const (
+	ReportStatusOpen      = "open"      // 未対応
+	ReportStatusResolved  = "resolved"  // 対応済み（非表示を維持）
+	ReportStatusDismissed = "dismissed" // 却下（表示に戻す）
+)
+
+// 通報への対応アクション
+const (
+	ReportActionHide    = "hide"
+	ReportActionDismiss = "dismiss"
+)
+
+// Report は投稿・コメントへの通報です
+// 匿名クライアントごとに同じ対象へは1回のみ通報できます
+type Report struct {
+	ID             uint       `json:"id" gorm:"primaryKey"`
+	TargetType     string     `json:"target_type" gorm:"size:16;not null;uniqueIndex:idx_reports_unique,priority:1;index:idx_reports_target,priority:1"`
+	TargetID       uint       `json:"target_id" gorm:"not null;uniqueIndex:idx_reports_unique,priority:2;index:idx_reports_target,priority:2"`
+	Reason         string     `json:"reason" gorm:"size:32;not null"`
+	Detail         string     `json:"detail" gorm:"type:text"`
+	ClientHash     string     `json:"-" gorm:"size:64;not null;uniqueIndex:idx_reports_unique,priority:3"`
+	IPHash         string     `json:"-" gorm:"size:64;not null;default:''"` // 接続元IPアドレスのハッシュ（自動非表示の件数に使用）
+	Status         string     `json:"status" gorm:"size:16;not null;default:open;index"`
+	ResolutionNote string     `json:"resolution_note" gorm:"type:text"`
+	ResolvedAt     *time.Time `json:"resolved_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ReportCreateRequest は通報作成リクエストの構造体
+type ReportCreateRequest struct {
+	Reason string `json:"reason" validate:"required,oneof=personal_info spam harassment inappropriate other"`
+	Detail string `json:"detail" validate:"text_max=500"`
+}
+
+// ReportResolveRequest は通報対応リクエストの構造体
+type ReportResolveRequest struct {
+	Action string `json:"action" validate:"required,oneof=hide dismiss"`
+	Note   string `json:"note" validate:"text_max=500"`
+}
+
+// ReportResponse は通報レスポンスの構造体
+type ReportResponse struct {
+	ID             uint       `json:"id"`
+	TargetType     string     `json:"target_type"`
+	TargetID       uint       `json:"target_id"`
+	Reason         string     `json:"reason"`
+	Detail         string     `json:"detail"`
+	Status         string     `json:"status"`
+	ResolutionNote string     `json:"resolution_note,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
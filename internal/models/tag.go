@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// Tag は投稿に付ける自由入力のタグです
+// 表記ゆれは NormalizedName で吸収し、最初に登録された表記を表示名とします
+type Tag struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	Name           string    `json:"name" gorm:"type:text;not null"`          // 表示名
+	NormalizedName string    `json:"-" gorm:"type:text;not null;uniqueIndex"` // 比較用に正規化したタグ名
+	PostCount      int64     `json:"post_count" gorm:"->;-:migration"`        // 集計クエリでのみ設定される投稿数
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TagResponse はタグ一覧レスポンスの構造体
+type TagResponse struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"`
+}
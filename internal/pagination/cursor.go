@@ -0,0 +1,68 @@
+// Package pagination はキーセット方式のページネーションで使用するカーソルを扱います
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor はカーソルの形式が不正な場合、または並び順が一致しない場合に返されます
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor は一覧上の位置を (並び替えキー, id) の組で表します
+// 並び替えキーは日時の場合はマイクロ秒単位の UNIX 時刻、件数の場合はその値を保持します
+// 発行時の並び順を保持し、異なる並び順の一覧では使用できません
+type Cursor struct {
+	Sort string
+	Key  int64
+	ID   uint
+}
+
+// New は指定された要素の直後から続きを取得するカーソルを作成します
+func New(sort string, key int64, id uint) *Cursor {
+	return &Cursor{Sort: sort, Key: key, ID: id}
+}
+
+// TimeKey は日時を並び替えキーに変換します
+// データベースの精度に合わせてマイクロ秒単位で保持します
+func TimeKey(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+// KeyTime は並び替えキーを日時として返します
+func (c *Cursor) KeyTime() time.Time {
+	return time.UnixMicro(c.Key).UTC()
+}
+
+// Encode はカーソルをクライアントに返す不透明な文字列に変換します
+func (c *Cursor) Encode() string {
+	raw := c.Sort + ":" + strconv.FormatInt(c.Key, 10) + ":" + strconv.FormatUint(uint64(c.ID), 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode は Encode で作成した文字列をカーソルに戻します
+// sort にはリクエストの並び順を指定し、カーソル発行時と異なる場合はエラーを返します
+func Decode(s, sort string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || parts[0] != sort {
+		return nil, ErrInvalidCursor
+	}
+	key, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil || id == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{Sort: sort, Key: key, ID: uint(id)}, nil
+}
@@ -0,0 +1,67 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestCursorRoundTrip は Encode した文字列を Decode すると同じカーソルに戻ることを確認します
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []*Cursor{
+		New("newest", TimeKey(time.Date(2025, 4, 1, 9, 30, 0, 123456000, time.UTC)), 42),
+		New("comments", 0, 1),
+		New("reactions", -5, 4294967295),
+	}
+	for _, want := range tests {
+		got, err := Decode(want.Encode(), want.Sort)
+		if err != nil {
+			t.Fatalf("Decode(%+v): %v", want, err)
+		}
+		if *got != *want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	}
+}
+
+// TestCursorKeyTime は日時の並び替えキーをマイクロ秒の精度で日時に戻せることを確認します
+func TestCursorKeyTime(t *testing.T) {
+	at := time.Date(2025, 4, 1, 9, 30, 0, 123456789, time.FixedZone("JST", 9*60*60))
+	got := New("newest", TimeKey(at), 1).KeyTime()
+
+	if want := at.Truncate(time.Microsecond); !got.Equal(want) {
+		t.Errorf("KeyTime() = %v, want %v", got, want)
+	}
+	if got.Location() != time.UTC {
+		t.Errorf("KeyTime() location = %v, want UTC", got.Location())
+	}
+}
+
+// TestDecodeInvalid は不正なカーソル・並び順の異なるカーソルを拒否することを確認します
+func TestDecodeInvalid(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	tests := []struct {
+		name   string
+		cursor string
+		sort   string
+	}{
+		{"not base64", "!!!", "newest"},
+		{"different sort", New("oldest", 1, 1).Encode(), "newest"},
+		{"missing part", encode("newest:1"), "newest"},
+		{"extra part", encode("newest:1:2:3"), "newest"},
+		{"non-numeric key", encode("newest:x:1"), "newest"},
+		{"zero id", encode("newest:1:0"), "newest"},
+		{"negative id", encode("newest:1:-1"), "newest"},
+		{"id overflow", encode("newest:1:4294967296"), "newest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.cursor, tt.sort); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("Decode(%q, %q) error = %v, want ErrInvalidCursor", tt.cursor, tt.sort, err)
+			}
+		})
+	}
+}
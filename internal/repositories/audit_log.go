@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepository interface {
+	Create(log *models.AuditLog) error
+	GetAll(limit, offset int, moderatorID uint, action string) ([]models.AuditLog, int64, error)
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Create(log *models.AuditLog) error {
+	return r.db.Create(log).Error
+}
+
+func (r *auditLogRepository) GetAll(limit, offset int, moderatorID uint, action string) ([]models.AuditLog, int64, error) {
+	var logs []models.AuditLog
+	var total int64
+
+	query := r.db.Model(&models.AuditLog{})
+
+	// フィルタリング
+	if moderatorID != 0 {
+		query = query.Where("moderator_id = ?", moderatorID)
+	}
+	if action != "" {
+		query = query.Where("action = ?", action)
+	}
+
+	// 総数を取得
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データを取得
+	err := query.Preload("Moderator").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&logs).Error
+
+	return logs, total, err
+}
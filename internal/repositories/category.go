@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type CategoryRepository interface {
+	GetActive() ([]models.Category, error)
+	ActiveNames() ([]string, error)
+	GetByName(name string) (*models.Category, error)
+}
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+// GetActive は有効なカテゴリを表示順に取得する
+func (r *categoryRepository) GetActive() ([]models.Category, error) {
+	var categories []models.Category
+	err := r.db.Where("active = ?", true).
+		Order("display_order").
+		Order("id").
+		Find(&categories).Error
+	return categories, err
+}
+
+// ActiveNames は有効なカテゴリ名を取得する（バリデーション用）
+func (r *categoryRepository) ActiveNames() ([]string, error) {
+	var names []string
+	err := r.db.Model(&models.Category{}).Where("active = ?", true).Pluck("name", &names).Error
+	return names, err
+}
+
+// GetByName はカテゴリ名からカテゴリを取得する（無効化したカテゴリも含む）
+func (r *categoryRepository) GetByName(name string) (*models.Category, error) {
+	var category models.Category
+	err := r.db.Where("name = ?", name).First(&category).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
@@ -2,14 +2,21 @@ package repositories
 
 import (
 	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CommentRepository interface {
 	Create(comment *models.Comment) error
-	GetByPostID(postID uint) ([]models.Comment, error)
+	GetRootsByPostID(postID uint, sort string, cursor *pagination.Cursor, limit int) ([]models.Comment, error)
+	GetRepliesByRootIDs(rootIDs []uint) ([]models.Comment, error)
 	GetByID(id uint) (*models.Comment, error)
-	CountByPostID(postID uint) (int64, error)
+	GetByIDIncludingHidden(id uint) (*models.Comment, error)
+	Update(comment *models.Comment) error
+	Delete(id uint) error
+	SetHidden(id uint, hidden bool) error
+	HardDelete(id uint) error
 }
 
 type commentRepository struct {
@@ -20,19 +27,73 @@ func NewCommentRepository(db *gorm.DB) CommentRepository {
 	return &commentRepository{db: db}
 }
 
+// Create はコメントを登録し、投稿のコメント数の加算と最終コメント日時の更新を行う
 func (r *commentRepository) Create(comment *models.Comment) error {
-	return r.db.Create(comment).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+		if err := adjustCommentCount(tx, comment.PostID, 1); err != nil {
+			return err
+		}
+		return tx.Model(&models.Post{}).Unscoped().
+			Where("id = ?", comment.PostID).
+			UpdateColumn("bumped_at", gorm.Expr("GREATEST(bumped_at, ?)", comment.CreatedAt)).Error
+	})
 }
 
-func (r *commentRepository) GetByPostID(postID uint) ([]models.Comment, error) {
+// commentOrders はコメント一覧の並び順ごとのキーセット
+var commentOrders = map[string]keysetOrder{
+	models.CommentSortNewest:    newestFirst,
+	models.CommentSortOldest:    {column: "created_at", timestamp: true},
+	models.CommentSortReactions: {column: "reaction_count", desc: true},
+}
+
+// GetRootsByPostID はトップレベルのコメントを指定された並び順で limit 件取得する（キーセット方式）
+// 論理削除・非表示のコメントも、表示中の返信がある場合はスレッドの起点として含める
+// cursor が nil の場合は先頭から取得する
+func (r *commentRepository) GetRootsByPostID(postID uint, sort string, cursor *pagination.Cursor, limit int) ([]models.Comment, error) {
+	order, ok := commentOrders[sort]
+	if !ok {
+		order = newestFirst
+	}
+
+	visibleReplies := r.db.Table("comments AS replies").Select("1").
+		Where("replies.root_id = comments.id AND replies.deleted_at IS NULL AND replies.hidden_at IS NULL")
+
 	var comments []models.Comment
-	err := r.db.Where("post_id = ?", postID).
-		Order("created_at DESC").
+	err := r.db.Unscoped().Scopes(order.scope("comments", cursor)).
+		Where("post_id = ? AND parent_id IS NULL", postID).
+		Where("(comments.deleted_at IS NULL AND comments.hidden_at IS NULL) OR EXISTS (?)", visibleReplies).
+		Limit(limit).
+		Find(&comments).Error
+	return comments, err
+}
+
+// GetRepliesByRootIDs は指定されたスレッドに属する返信をまとめて取得する
+func (r *commentRepository) GetRepliesByRootIDs(rootIDs []uint) ([]models.Comment, error) {
+	var comments []models.Comment
+	if len(rootIDs) == 0 {
+		return comments, nil
+	}
+	err := r.db.Scopes(visibleComments).Where("root_id IN ?", rootIDs).
+		Order("created_at ASC").
 		Find(&comments).Error
 	return comments, err
 }
 
 func (r *commentRepository) GetByID(id uint) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.db.Scopes(visibleComments).First(&comment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// GetByIDIncludingHidden は非表示になったコメントも含めて取得する（論理削除したコメントは除く）
+// 投稿者が編集用トークンで自分のコメントを編集・削除する場合に使用する
+func (r *commentRepository) GetByIDIncludingHidden(id uint) (*models.Comment, error) {
 	var comment models.Comment
 	err := r.db.First(&comment, id).Error
 	if err != nil {
@@ -41,8 +102,78 @@ func (r *commentRepository) GetByID(id uint) (*models.Comment, error) {
 	return &comment, nil
 }
 
-func (r *commentRepository) CountByPostID(postID uint) (int64, error) {
-	var count int64
-	err := r.db.Model(&models.Comment{}).Where("post_id = ?", postID).Count(&count).Error
-	return count, err
+// Update はコメントの内容を更新する
+// リアクション数など他の操作で更新される集計カラムは上書きしない
+func (r *commentRepository) Update(comment *models.Comment) error {
+	return r.db.Omit("reaction_count", "hidden_at", clause.Associations).Save(comment).Error
+}
+
+// Delete はコメントを論理削除し（deleted_at を設定）、投稿のコメント数を減算する
+func (r *commentRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var comment models.Comment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&comment, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&comment).Error; err != nil {
+			return err
+		}
+		if comment.HiddenAt != nil {
+			return nil
+		}
+		return adjustCommentCount(tx, comment.PostID, -1)
+	})
+}
+
+// SetHidden はコメントの非表示状態を切り替え、投稿のコメント数に反映する
+func (r *commentRepository) SetHidden(id uint, hidden bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var comment models.Comment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&comment, id).Error; err != nil {
+			return err
+		}
+		if (comment.HiddenAt != nil) == hidden {
+			return nil
+		}
+		if err := tx.Model(&comment).Update("hidden_at", hiddenAtValue(hidden)).Error; err != nil {
+			return err
+		}
+		delta := int64(1)
+		if hidden {
+			delta = -1
+		}
+		return adjustCommentCount(tx, comment.PostID, delta)
+	})
+}
+
+// HardDelete はコメントとそのリアクション・通報を物理削除する
+// 返信は残り、ツリー表示ではスレッドの起点またはトップレベルに配置される
+func (r *commentRepository) HardDelete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var comment models.Comment
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).First(&comment, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&comment).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("target_type = ? AND target_id = ?", models.ReactionTargetComment, id).Delete(&models.Reaction{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("target_type = ? AND target_id = ?", models.ReportTargetComment, id).Delete(&models.Report{}).Error; err != nil {
+			return err
+		}
+		if comment.DeletedAt.Valid || comment.HiddenAt != nil {
+			return nil
+		}
+		return adjustCommentCount(tx, comment.PostID, -1)
+	})
+}
+
+// adjustCommentCount は投稿の非正規化されたコメント数を増減する
+// 同時実行時も正しく集計されるよう、再集計ではなく加減算で更新する
+func adjustCommentCount(tx *gorm.DB, postID uint, delta int64) error {
+	return tx.Model(&models.Post{}).Unscoped().
+		Where("id = ?", postID).
+		UpdateColumn("comment_count", gorm.Expr("comment_count + ?", delta)).Error
 }
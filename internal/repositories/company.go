@@ -0,0 +1,195 @@
+package repositories
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/search"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CompanyRepository interface {
+	Resolve(name string) (*models.Company, error)
+	FindByKey(key string) (*models.Company, error)
+	GetByID(id uint) (*models.Company, error)
+	GetAll(limit, offset int, keyword string) ([]models.Company, int64, error)
+	ListAll() ([]models.Company, error)
+	Update(company *models.Company, aliases []string) error
+	Merge(targetID, sourceID uint) error
+}
+
+type companyRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyRepository(db *gorm.DB) CompanyRepository {
+	return &companyRepository{db: db}
+}
+
+// Resolve は企業名に対応する企業を返す
+// 正規化した企業名・別名のいずれにも一致しない場合は新しい企業として登録する
+func (r *companyRepository) Resolve(name string) (*models.Company, error) {
+	name = strings.TrimSpace(name)
+	key := search.CompanyNameKey(name)
+
+	company, err := r.FindByKey(key)
+	if err == nil {
+		return company, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// 同時に登録された場合に備えて重複時は何もしない
+	company = &models.Company{Name: name, NormalizedName: key}
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(company).Error; err != nil {
+		return nil, err
+	}
+	if company.ID != 0 {
+		return company, nil
+	}
+	return r.FindByKey(key)
+}
+
+// FindByKey は正規化済みの企業名または別名で企業を検索する
+func (r *companyRepository) FindByKey(key string) (*models.Company, error) {
+	var company models.Company
+	err := r.db.Where("normalized_name = ?", key).
+		Or("id IN (?)", r.db.Model(&models.CompanyAlias{}).Select("company_id").Where("normalized_alias = ?", key)).
+		First(&company).Error
+	if err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+// GetByID は企業を別名・投稿数とともに取得する
+func (r *companyRepository) GetByID(id uint) (*models.Company, error) {
+	var company models.Company
+	err := r.withPostCount(r.db.Model(&models.Company{})).
+		Preload("Aliases", func(db *gorm.DB) *gorm.DB {
+			return db.Order("alias")
+		}).
+		Where("companies.id = ?", id).
+		First(&company).Error
+	if err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+// GetAll は企業一覧を投稿数の多い順に取得する
+// keyword を指定した場合は企業名・読み・別名で絞り込む
+func (r *companyRepository) GetAll(limit, offset int, keyword string) ([]models.Company, int64, error) {
+	var companies []models.Company
+	var total int64
+
+	query := r.db.Model(&models.Company{})
+
+	// フィルタリング
+	if keyword != "" {
+		like := "%" + search.CompanyKey(keyword) + "%"
+		query = query.Where("companies.normalized_name LIKE ? OR companies.kana LIKE ? OR companies.id IN (?)",
+			like, like,
+			r.db.Model(&models.CompanyAlias{}).Select("company_id").Where("normalized_alias LIKE ?", like))
+	}
+
+	// 総数を取得
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// データを取得
+	err := r.withPostCount(query).
+		Preload("Aliases", func(db *gorm.DB) *gorm.DB {
+			return db.Order("alias")
+		}).
+		Order("post_count DESC").
+		Order("companies.name").
+		Limit(limit).
+		Offset(offset).
+		Find(&companies).Error
+
+	return companies, total, err
+}
+
+// ListAll はすべての企業を別名・投稿数とともに取得する（補完用インデックスの構築に使用）
+func (r *companyRepository) ListAll() ([]models.Company, error) {
+	var companies []models.Company
+	err := r.withPostCount(r.db.Model(&models.Company{})).
+		Preload("Aliases").
+		Find(&companies).Error
+	return companies, err
+}
+
+// withPostCount は公開中の投稿数を post_count として集計する
+func (r *companyRepository) withPostCount(db *gorm.DB) *gorm.DB {
+	return db.Select("companies.*, COUNT(posts.id) AS post_count").
+		Joins("LEFT JOIN posts ON posts.company_id = companies.id AND posts.deleted_at IS NULL AND posts.hidden_at IS NULL").
+		Group("companies.id")
+}
+
+// Update は企業情報を更新し、別名を置き換える
+// 企業名の変更は投稿の company_name にも反映する
+func (r *companyRepository) Update(company *models.Company, aliases []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(company).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Post{}).Unscoped().
+			Where("company_id = ?", company.ID).
+			Update("company_name", company.Name).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("company_id = ?", company.ID).Delete(&models.CompanyAlias{}).Error; err != nil {
+			return err
+		}
+		for _, alias := range aliases {
+			record := &models.CompanyAlias{
+				CompanyID:       company.ID,
+				Alias:           alias,
+				NormalizedAlias: search.CompanyNameKey(alias),
+			}
+			if err := tx.Create(record).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Merge は sourceID の企業を targetID の企業に統合する
+// 投稿と別名を移し、統合元の企業名を別名として登録した上で統合元を削除する
+func (r *companyRepository) Merge(targetID, sourceID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var target, source models.Company
+		if err := tx.First(&target, targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.First(&source, sourceID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Post{}).Unscoped().
+			Where("company_id = ?", source.ID).
+			Updates(map[string]interface{}{"company_id": target.ID, "company_name": target.Name}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.CompanyAlias{}).
+			Where("company_id = ?", source.ID).
+			Update("company_id", target.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&source).Error; err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.CompanyAlias{
+			CompanyID:       target.ID,
+			Alias:           source.Name,
+			NormalizedAlias: source.NormalizedName,
+		}).Error
+	})
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type ModeratorRepository interface {
+	Create(moderator *models.Moderator) error
+	GetByID(id uint) (*models.Moderator, error)
+	GetByUsername(username string) (*models.Moderator, error)
+	UpdateLastLogin(id uint, at time.Time) error
+	CreateSession(session *models.ModeratorSession) error
+	GetSessionByTokenHash(tokenHash string) (*models.ModeratorSession, error)
+	DeleteSession(tokenHash string) error
+	DeleteExpiredSessions() error
+}
+
+type moderatorRepository struct {
+	db *gorm.DB
+}
+
+func NewModeratorRepository(db *gorm.DB) ModeratorRepository {
+	return &moderatorRepository{db: db}
+}
+
+func (r *moderatorRepository) Create(moderator *models.Moderator) error {
+	return r.db.Create(moderator).Error
+}
+
+func (r *moderatorRepository) GetByID(id uint) (*models.Moderator, error) {
+	var moderator models.Moderator
+	err := r.db.First(&moderator, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &moderator, nil
+}
+
+func (r *moderatorRepository) GetByUsername(username string) (*models.Moderator, error) {
+	var moderator models.Moderator
+	err := r.db.Where("username = ?", username).First(&moderator).Error
+	if err != nil {
+		return nil, err
+	}
+	return &moderator, nil
+}
+
+func (r *moderatorRepository) UpdateLastLogin(id uint, at time.Time) error {
+	return r.db.Model(&models.Moderator{}).Where("id = ?", id).Update("last_login_at", at).Error
+}
+
+func (r *moderatorRepository) CreateSession(session *models.ModeratorSession) error {
+	return r.db.Create(session).Error
+}
+
+// GetSessionByTokenHash は有効期限内のセッションをモデレーター情報とともに取得する
+func (r *moderatorRepository) GetSessionByTokenHash(tokenHash string) (*models.ModeratorSession, error) {
+	var session models.ModeratorSession
+	err := r.db.Preload("Moderator").
+		Where("token_hash = ? AND expires_at > ?", tokenHash, time.Now()).
+		First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *moderatorRepository) DeleteSession(tokenHash string) error {
+	return r.db.Where("token_hash = ?", tokenHash).Delete(&models.ModeratorSession{}).Error
+}
+
+func (r *moderatorRepository) DeleteExpiredSessions() error {
+	return r.db.Where("expires_at <= ?", time.Now()).Delete(&models.ModeratorSession{}).Error
+}
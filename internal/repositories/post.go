@@ -1,15 +1,26 @@
 package repositories
 
 import (
+	"strings"
+
 	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
+	"github.com/latttchc/finding-forest-backend/internal/search"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PostRepository interface {
 	Create(post *models.Post) error
 	GetByID(id uint) (*models.Post, error)
-	GetAll(limit, offset int, category, companyName string) ([]models.Post, int64, error)
-	GetWithComments(id uint) (*models.Post, error)
+	GetByIDIncludingHidden(id uint) (*models.Post, error)
+	GetAll(filter models.PostFilter, limit, offset int) ([]models.Post, int64, error)
+	GetAfter(filter models.PostFilter, cursor *pagination.Cursor, limit int) ([]models.Post, error)
+	Update(post *models.Post) error
+	Delete(id uint) error
+	SetHidden(id uint, hidden bool) error
+	HardDelete(id uint) error
+	GetForExport(afterID uint, limit int) ([]models.Post, error)
 }
 
 type postRepository struct {
@@ -21,39 +32,63 @@ func NewPostRepository(db *gorm.DB) PostRepository {
 }
 
 func (r *postRepository) Create(post *models.Post) error {
+	post.SearchText = search.IndexText(post.Title, post.Content)
+	if post.BumpedAt.IsZero() {
+		post.BumpedAt = r.db.NowFunc()
+	}
 	return r.db.Create(post).Error
 }
 
 func (r *postRepository) GetByID(id uint) (*models.Post, error) {
 	var post models.Post
-	err := r.db.First(&post, id).Error
+	err := r.db.Scopes(preloadPostDetails, visiblePosts).First(&post, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// GetByIDIncludingHidden は非表示になった投稿も含めて取得する（論理削除した投稿は除く）
+// 投稿者が編集用トークンで自分の投稿を編集・削除する場合に使用する
+func (r *postRepository) GetByIDIncludingHidden(id uint) (*models.Post, error) {
+	var post models.Post
+	err := r.db.Scopes(preloadPostDetails).First(&post, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &post, nil
 }
 
-func (r *postRepository) GetAll(limit, offset int, category, companyName string) ([]models.Post, int64, error) {
+// postOrders は投稿一覧の並び順ごとのキーセット
+var postOrders = map[string]keysetOrder{
+	models.PostSortNewest:    newestFirst,
+	models.PostSortOldest:    {column: "created_at", timestamp: true},
+	models.PostSortComments:  {column: "comment_count", desc: true},
+	models.PostSortBumped:    {column: "bumped_at", desc: true, timestamp: true},
+	models.PostSortReactions: {column: "reaction_count", desc: true},
+}
+
+// GetAll は投稿一覧を取得する
+// キーワードを指定した場合はタイトル・本文の全文検索を行い、並び順が未指定であれば関連度の高い順に並べる
+func (r *postRepository) GetAll(filter models.PostFilter, limit, offset int) ([]models.Post, int64, error) {
 	var posts []models.Post
 	var total int64
 
-	query := r.db.Model(&models.Post{})
-
-	// フィルタリング
-	if category != "" {
-		query = query.Where("category = ?", category)
-	}
-	if companyName != "" {
-		query = query.Where("company_name ILIKE ?", "%"+companyName+"%")
-	}
+	terms := search.ParseQuery(filter.Keyword)
+	query := filterPosts(r.db.Model(&models.Post{}).Scopes(visiblePosts), filter, terms)
 
 	// 総数を取得
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
+	// 並び順（検索時は関連度順）
+	if filter.Sort == "" && len(terms) > 0 {
+		query = query.Order(relevanceOrder(terms))
+	}
+
 	// データを取得
-	err := query.Order("created_at DESC").
+	err := query.Scopes(preloadPostDetails, postOrder(filter.Sort).scope("posts", nil)).
 		Limit(limit).
 		Offset(offset).
 		Find(&posts).Error
@@ -61,11 +96,227 @@ func (r *postRepository) GetAll(limit, offset int, category, companyName string)
 	return posts, total, err
 }
 
-func (r *postRepository) GetWithComments(id uint) (*models.Post, error) {
-	var post models.Post
-	err := r.db.Preload("Comments").First(&post, id).Error
-	if err != nil {
-		return nil, err
+// GetAfter はカーソル以降の投稿を指定された並び順で取得する（キーセット方式）
+// cursor が nil の場合は先頭から取得する。件数の集計は行わず、検索時も関連度順にはしない
+func (r *postRepository) GetAfter(filter models.PostFilter, cursor *pagination.Cursor, limit int) ([]models.Post, error) {
+	var posts []models.Post
+
+	query := filterPosts(r.db.Model(&models.Post{}).Scopes(visiblePosts), filter, search.ParseQuery(filter.Keyword))
+	err := query.Scopes(preloadPostDetails, postOrder(filter.Sort).scope("posts", cursor)).
+		Limit(limit).
+		Find(&posts).Error
+
+	return posts, err
+}
+
+// postOrder は並び順に対応するキーセットを返す（未指定・不明な場合は新しい順）
+func postOrder(sort string) keysetOrder {
+	if order, ok := postOrders[sort]; ok {
+		return order
 	}
-	return &post, nil
+	return newestFirst
+}
+
+// preloadPostDetails は投稿に付随する面接情報とタグを読み込むスコープ
+func preloadPostDetails(db *gorm.DB) *gorm.DB {
+	return db.Preload("Interview").Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tags.name")
+	})
+}
+
+// filterPosts は一覧取得の絞り込み条件を適用する
+func filterPosts(query *gorm.DB, filter models.PostFilter, terms []string) *gorm.DB {
+	if len(filter.Categories) > 0 {
+		query = query.Where("posts.category IN ?", filter.Categories)
+	}
+	if filter.CompanyName != "" {
+		query = query.Where("posts.company_name ILIKE ?", "%"+filter.CompanyName+"%")
+	}
+	if filter.CompanyID != 0 {
+		query = query.Where("posts.company_id = ?", filter.CompanyID)
+	}
+	if filter.JobType != "" {
+		query = query.Where("posts.job_type ILIKE ?", "%"+filter.JobType+"%")
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("posts.created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("posts.created_at < ?", *filter.CreatedBefore)
+	}
+	if filter.HasComments != nil {
+		if *filter.HasComments {
+			query = query.Where("posts.comment_count > 0")
+		} else {
+			query = query.Where("posts.comment_count = 0")
+		}
+	}
+	if filter.InterviewStage != "" || filter.InterviewFormat != "" || filter.InterviewOutcome != "" {
+		query = whereInterview(query, filter)
+	}
+	if filter.Tag != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE post_tags.post_id = posts.id AND tags.normalized_name = ?)",
+			search.TagKey(filter.Tag))
+	}
+
+	// 全文検索
+	if len(terms) > 0 {
+		query = whereMatches(query, terms)
+	}
+	return query
+}
+
+// whereInterview は面接情報の条件に一致する投稿に絞り込む
+func whereInterview(query *gorm.DB, filter models.PostFilter) *gorm.DB {
+	conds := []string{"post_interviews.post_id = posts.id"}
+	var args []interface{}
+	if filter.InterviewStage != "" {
+		conds = append(conds, "post_interviews.stage = ?")
+		args = append(args, filter.InterviewStage)
+	}
+	if filter.InterviewFormat != "" {
+		conds = append(conds, "post_interviews.format = ?")
+		args = append(args, filter.InterviewFormat)
+	}
+	if filter.InterviewOutcome != "" {
+		conds = append(conds, "post_interviews.outcome = ?")
+		args = append(args, filter.InterviewOutcome)
+	}
+	return query.Where("EXISTS (SELECT 1 FROM post_interviews WHERE "+strings.Join(conds, " AND ")+")", args...)
+}
+
+// 全文検索で使用する正規化済みのタイトル・本文の式
+const (
+	normalizedTitle   = "lower(normalize(posts.title, NFKC))"
+	normalizedContent = "lower(normalize(posts.content, NFKC))"
+)
+
+// whereMatches はすべての検索語をタイトルまたは本文に含む投稿に絞り込む
+// バイグラムの GIN インデックスで候補を絞った上で、検索語が連続して含まれるかを確認する
+func whereMatches(db *gorm.DB, terms []string) *gorm.DB {
+	if tokens := search.QueryTokens(terms); len(tokens) > 0 {
+		db = db.Where("string_to_array(posts.search_text, ' ') @> string_to_array(?, ' ')", strings.Join(tokens, " "))
+	}
+	for _, term := range terms {
+		db = db.Where("(strpos("+normalizedTitle+", ?) > 0 OR strpos("+normalizedContent+", ?) > 0)", term, term)
+	}
+	return db
+}
+
+// relevanceOrder は検索語の出現箇所による関連度順の並び順を返す
+// タイトルに含まれる検索語を本文より重く評価する
+func relevanceOrder(terms []string) clause.OrderBy {
+	parts := make([]string, 0, len(terms))
+	vars := make([]interface{}, 0, len(terms)*2)
+	for _, term := range terms {
+		parts = append(parts, "(CASE WHEN strpos("+normalizedTitle+", ?) > 0 THEN 3 ELSE 0 END + CASE WHEN strpos("+normalizedContent+", ?) > 0 THEN 1 ELSE 0 END)")
+		vars = append(vars, term, term)
+	}
+	return clause.OrderBy{Expression: clause.Expr{
+		SQL:                "(" + strings.Join(parts, " + ") + ") DESC",
+		Vars:               vars,
+		WithoutParentheses: true,
+	}}
+}
+
+// Update は投稿の内容・面接情報・タグを更新する
+// コメント数など他の操作で更新される集計カラムは上書きしない
+func (r *postRepository) Update(post *models.Post) error {
+	post.SearchText = search.IndexText(post.Title, post.Content)
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("comment_count", "reaction_count", "bumped_at", "hidden_at", clause.Associations).Save(post).Error; err != nil {
+			return err
+		}
+
+		// タグ・面接情報は置き換える
+		if err := tx.Model(post).Association("Tags").Replace(post.Tags); err != nil {
+			return err
+		}
+		if err := tx.Where("post_id = ?", post.ID).Delete(&models.PostInterview{}).Error; err != nil {
+			return err
+		}
+		if post.Interview == nil {
+			return nil
+		}
+		post.Interview.ID = 0
+		post.Interview.PostID = post.ID
+		return tx.Create(post.Interview).Error
+	})
+}
+
+// Delete は投稿とそのコメントを論理削除する（deleted_at を設定）
+func (r *postRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", id).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Post{}, id).Error
+	})
+}
+
+// SetHidden は投稿の非表示状態を切り替える
+func (r *postRepository) SetHidden(id uint, hidden bool) error {
+	result := r.db.Model(&models.Post{}).Where("id = ?", id).
+		Update("hidden_at", hiddenAtValue(hidden))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// HardDelete は投稿とそのコメント・リアクション・通報を物理削除する
+// コメント・面接情報・タグの関連は投稿を外部キーで参照しているため、それらを先に削除し投稿は最後に削除する
+func (r *postRepository) HardDelete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		commentIDs := tx.Unscoped().Model(&models.Comment{}).Select("id").Where("post_id = ?", id)
+		if err := tx.Where("target_type = ? AND target_id IN (?)", models.ReactionTargetComment, commentIDs).Delete(&models.Reaction{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("target_type = ? AND target_id IN (?)", models.ReportTargetComment, commentIDs).Delete(&models.Report{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("post_id = ?", id).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("post_id = ?", id).Delete(&models.PostInterview{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM post_tags WHERE post_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("target_type = ? AND target_id = ?", models.ReactionTargetPost, id).Delete(&models.Reaction{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("target_type = ? AND target_id = ?", models.ReportTargetPost, id).Delete(&models.Report{}).Error; err != nil {
+			return err
+		}
+
+		// 投稿が存在しない場合はロールバックされ、関連データも削除されない
+		result := tx.Unscoped().Delete(&models.Post{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// GetForExport はエクスポート用に afterID より後の投稿を ID 順に取得する
+// 非表示の投稿・コメントも含め、面接情報・タグ・コメントをあわせて読み込む
+func (r *postRepository) GetForExport(afterID uint, limit int) ([]models.Post, error) {
+	var posts []models.Post
+	err := r.db.Scopes(preloadPostDetails).
+		Preload("Comments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("comments.id")
+		}).
+		Where("posts.id > ?", afterID).
+		Order("posts.id").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
 }
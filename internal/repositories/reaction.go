@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReactionRepository interface {
+	Add(reaction *models.Reaction) error
+	Remove(targetType string, targetID uint, kind, clientHash string) error
+	CountByTarget(targetType string, targetID uint) (models.ReactionCounts, error)
+	CountByTargets(targetType string, targetIDs []uint) (map[uint]models.ReactionCounts, error)
+	GetKindsByClient(targetType string, targetID uint, clientHash string) ([]string, error)
+}
+
+type reactionRepository struct {
+	db *gorm.DB
+}
+
+func NewReactionRepository(db *gorm.DB) ReactionRepository {
+	return &reactionRepository{db: db}
+}
+
+// Add はリアクションを追加し、対象のリアクション数を加算する（既に存在する場合は何もしない）
+func (r *reactionRepository) Add(reaction *models.Reaction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(reaction)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return adjustReactionCount(tx, reaction.TargetType, reaction.TargetID, 1)
+	})
+}
+
+// Remove はリアクションを削除し、対象のリアクション数を減算する（存在しない場合も成功とする）
+func (r *reactionRepository) Remove(targetType string, targetID uint, kind, clientHash string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("target_type = ? AND target_id = ? AND kind = ? AND client_hash = ?", targetType, targetID, kind, clientHash).
+			Delete(&models.Reaction{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return adjustReactionCount(tx, targetType, targetID, -1)
+	})
+}
+
+func (r *reactionRepository) CountByTarget(targetType string, targetID uint) (models.ReactionCounts, error) {
+	counts, err := r.CountByTargets(targetType, []uint{targetID})
+	if err != nil {
+		return models.ReactionCounts{}, err
+	}
+	return counts[targetID], nil
+}
+
+// CountByTargets は複数の対象のリアクション件数を1クエリで集計する
+func (r *reactionRepository) CountByTargets(targetType string, targetIDs []uint) (map[uint]models.ReactionCounts, error) {
+	counts := make(map[uint]models.ReactionCounts, len(targetIDs))
+	if len(targetIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		TargetID uint
+		Kind     string
+		Count    int64
+	}
+	err := r.db.Model(&models.Reaction{}).
+		Select("target_id, kind, COUNT(*) AS count").
+		Where("target_type = ? AND target_id IN ?", targetType, targetIDs).
+		Group("target_id, kind").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		c := counts[row.TargetID]
+		c.Add(row.Kind, row.Count)
+		counts[row.TargetID] = c
+	}
+	return counts, nil
+}
+
+func (r *reactionRepository) GetKindsByClient(targetType string, targetID uint, clientHash string) ([]string, error) {
+	kinds := []string{}
+	err := r.db.Model(&models.Reaction{}).
+		Where("target_type = ? AND target_id = ? AND client_hash = ?", targetType, targetID, clientHash).
+		Order("kind").
+		Pluck("kind", &kinds).Error
+	return kinds, err
+}
+
+// adjustReactionCount は対象の非正規化されたリアクション数を delta だけ増減する
+func adjustReactionCount(tx *gorm.DB, targetType string, targetID uint, delta int64) error {
+	var model interface{}
+	switch targetType {
+	case models.ReactionTargetPost:
+		model = &models.Post{}
+	case models.ReactionTargetComment:
+		model = &models.Comment{}
+	default:
+		return nil
+	}
+	return tx.Model(model).Unscoped().
+		Where("id = ?", targetID).
+		UpdateColumn("reaction_count", gorm.Expr("reaction_count + ?", delta)).Error
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReportRepository interface {
+	Create(report *models.Report) (bool, error)
+	GetByID(id uint) (*models.Report, error)
+	GetAll(limit, offset int, status string) ([]models.Report, int64, error)
+	CountOpenReporters(targetType string, targetID uint) (int64, error)
+	ResolveByTarget(targetType string, targetID uint, status, note string) error
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// Create は通報を登録する
+// 同じクライアントが同じ対象を通報済みの場合は登録せず false を返す
+func (r *reportRepository) Create(report *models.Report) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *reportRepository) GetByID(id uint) (*models.Report, error) {
+	var report models.Report
+	err := r.db.First(&report, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// GetAll は通報一覧を古い順に取得する（対応待ちのキューとして処理するため）
+func (r *reportRepository) GetAll(limit, offset int, status string) ([]models.Report, int64, error) {
+	var reports []models.Report
+	var total int64
+
+	query := r.db.Model(&models.Report{})
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&reports).Error
+
+	return reports, total, err
+}
+
+// CountOpenReporters は対象に対する未対応の通報を、接続元IPアドレスごとに1件として数える
+// クライアント識別子はリクエスト元が自由に変えられるため、自動非表示の判定にはこの件数を使用する
+func (r *reportRepository) CountOpenReporters(targetType string, targetID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportStatusOpen).
+		Distinct("ip_hash").
+		Count(&count).Error
+	return count, err
+}
+
+// ResolveByTarget は対象に対する未対応の通報をすべて対応済みにする
+func (r *reportRepository) ResolveByTarget(targetType string, targetID uint, status, note string) error {
+	return r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportStatusOpen).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"resolution_note": note,
+			"resolved_at":     time.Now(),
+		}).Error
+}
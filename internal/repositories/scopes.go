@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
+	"gorm.io/gorm"
+)
+
+// visiblePosts は通報・モデレーションで非表示になった投稿を除外するスコープ
+func visiblePosts(db *gorm.DB) *gorm.DB {
+	return db.Where("posts.hidden_at IS NULL")
+}
+
+// visibleComments は通報・モデレーションで非表示になったコメントを除外するスコープ
+func visibleComments(db *gorm.DB) *gorm.DB {
+	return db.Where("comments.hidden_at IS NULL")
+}
+
+// keysetOrder はキーセット方式のページネーションで使用する並び順を表す
+// column で並べ、値が同じ行は id で並べる
+type keysetOrder struct {
+	column    string // 並び替えに使用するカラム
+	desc      bool   // 降順かどうか
+	timestamp bool   // カラムが日時かどうか（カーソルのキーを日時に変換する）
+}
+
+// scope は並び順と、cursor が指定されていればカーソル以降への絞り込みを適用するスコープを返す
+func (o keysetOrder) scope(table string, cursor *pagination.Cursor) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		dir, op := "ASC", ">"
+		if o.desc {
+			dir, op = "DESC", "<"
+		}
+		if cursor != nil {
+			var key interface{} = cursor.Key
+			if o.timestamp {
+				key = cursor.KeyTime()
+			}
+			db = db.Where("("+table+"."+o.column+", "+table+".id) "+op+" (?, ?)", key, cursor.ID)
+		}
+		return db.Order(table + "." + o.column + " " + dir + ", " + table + ".id " + dir)
+	}
+}
+
+// newestFirst は新しい順（created_at DESC, id DESC）の並び順
+var newestFirst = keysetOrder{column: "created_at", desc: true, timestamp: true}
+
+// hiddenAtValue は非表示フラグから hidden_at カラムに設定する値を返す
+func hiddenAtValue(hidden bool) *time.Time {
+	if !hidden {
+		return nil
+	}
+	now := time.Now()
+	return &now
+}
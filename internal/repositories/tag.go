@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/search"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TagRepository interface {
+	Resolve(names []string) ([]models.Tag, error)
+	GetAll(limit int, keyword string) ([]models.Tag, error)
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db: db}
+}
+
+// Resolve はタグ名に対応するタグを返す
+// 正規化したタグ名が一致するタグが無い場合は新しいタグとして登録し、表記ゆれによる重複は1つにまとめる
+func (r *tagRepository) Resolve(names []string) ([]models.Tag, error) {
+	tags := []models.Tag{}
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = search.CleanTag(name)
+		key := search.TagKey(name)
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		// 同時に登録された場合に備えて重複時は何もしない
+		tag := models.Tag{Name: name, NormalizedName: key}
+		if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&tag).Error; err != nil {
+			return nil, err
+		}
+		if tag.ID == 0 {
+			if err := r.db.Where("normalized_name = ?", key).First(&tag).Error; err != nil {
+				return nil, err
+			}
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// GetAll は公開中の投稿に付いているタグを投稿数の多い順に取得する
+// keyword を指定した場合は正規化したタグ名の部分一致で絞り込む
+func (r *tagRepository) GetAll(limit int, keyword string) ([]models.Tag, error) {
+	var tags []models.Tag
+
+	query := r.db.Model(&models.Tag{}).
+		Select("tags.*, COUNT(posts.id) AS post_count").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Joins("JOIN posts ON posts.id = post_tags.post_id AND posts.deleted_at IS NULL AND posts.hidden_at IS NULL").
+		Group("tags.id")
+
+	// フィルタリング
+	if key := search.TagKey(keyword); key != "" {
+		query = query.Where("tags.normalized_name LIKE ?", "%"+key+"%")
+	}
+
+	err := query.Order("post_count DESC").
+		Order("tags.name").
+		Limit(limit).
+		Find(&tags).Error
+
+	return tags, err
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import "gorm.io/gorm"
+
+// TxRepositories は同じトランザクションで操作するリポジトリ
+type TxRepositories struct {
+	Posts     PostRepository
+	Comments  CommentRepository
+	Reports   ReportRepository
+	Companies CompanyRepository
+	AuditLogs AuditLogRepository
+}
+
+// Transactor は複数のリポジトリにまたがる操作を1つのトランザクションで実行する
+type Transactor interface {
+	Transaction(fn func(repos TxRepositories) error) error
+}
+
+type transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) Transactor {
+	return &transactor{db: db}
+}
+
+// Transaction は fn にトランザクション内で操作するリポジトリを渡して実行し、fn がエラーを返した場合はロールバックする
+// 各リポジトリのメソッド内のトランザクションはセーブポイントとして扱われる
+func (t *transactor) Transaction(fn func(repos TxRepositories) error) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		return fn(TxRepositories{
+			Posts:     NewPostRepository(tx),
+			Comments:  NewCommentRepository(tx),
+			Reports:   NewReportRepository(tx),
+			Companies: NewCompanyRepository(tx),
+			AuditLogs: NewAuditLogRepository(tx),
+		})
+	})
+}
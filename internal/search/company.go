@@ -0,0 +1,61 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// companyEntityMarks は企業名の比較時に取り除く法人格の表記です（正規化後の表記）
+// 前株・後株のどちらにも付くため、位置に関わらず取り除きます
+var companyEntityMarks = []string{
+	"株式会社", "有限会社", "合同会社", "合資会社", "合名会社",
+	"(株)", "(有)", "(合)",
+}
+
+// companyEntitySuffixes は企業名の末尾にある場合のみ取り除く英語の法人格の表記です
+var companyEntitySuffixes = []string{
+	"co.,ltd.", "co., ltd.", "co.ltd.", "inc.", "inc", "corp.", "corporation", "ltd.", "llc",
+}
+
+// CompanyKey は企業名の表記ゆれを吸収した比較用のキーを返します
+// NFKC 正規化・小文字化の後、法人格・空白・記号を取り除き、カタカナをひらがなに揃えます
+func CompanyKey(name string) string {
+	s := Normalize(name)
+	for _, mark := range companyEntityMarks {
+		s = strings.ReplaceAll(s, mark, "")
+	}
+	s = strings.TrimSpace(s)
+	for _, suffix := range companyEntitySuffixes {
+		if trimmed, ok := strings.CutSuffix(s, " "+suffix); ok {
+			s = trimmed
+			break
+		}
+	}
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return -1
+		}
+		return r
+	}, s)
+	return ToHiragana(s)
+}
+
+// CompanyNameKey は企業名・別名を保存・照合するためのキーを返します
+// 法人格や記号だけの名前で CompanyKey が空になる場合は、正規化した名前をそのまま使います
+func CompanyNameKey(name string) string {
+	if key := CompanyKey(name); key != "" {
+		return key
+	}
+	return Normalize(name)
+}
+
+// ToHiragana はカタカナをひらがなに変換します
+// 長音記号など対応するひらがなが無い文字はそのまま残します
+func ToHiragana(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'ァ' && r <= 'ヶ' {
+			return r - ('ァ' - 'ぁ')
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,47 @@
+package search
+
+import "testing"
+
+// TestCompanyKey は企業名の表記ゆれが同じキーになることを確認します
+func TestCompanyKey(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{[]string{"株式会社サンプル", "サンプル株式会社", "(株)サンプル", "㈱サンプル", "ｻﾝﾌﾟﾙ", "さんぷる", "サンプル 株式会社"}, "さんぷる"},
+		{[]string{"Example Inc.", "EXAMPLE", "Ｅｘａｍｐｌｅ", "Example Co., Ltd.", "Example Corporation", "example llc"}, "example"},
+		{[]string{"A・B-C", "a b c"}, "abc"},
+		{[]string{"コーヒー"}, "こーひー"},
+	}
+	for _, tt := range tests {
+		for _, name := range tt.names {
+			if got := CompanyKey(name); got != tt.want {
+				t.Errorf("CompanyKey(%q) = %q, want %q", name, got, tt.want)
+			}
+		}
+	}
+}
+
+// TestCompanyKeySuffixOnly は英語の法人格を末尾以外では取り除かないことを確認します
+func TestCompanyKeySuffixOnly(t *testing.T) {
+	if got := CompanyKey("Inc Design"); got != "incdesign" {
+		t.Errorf("CompanyKey(%q) = %q, want %q", "Inc Design", got, "incdesign")
+	}
+}
+
+// TestCompanyNameKey は CompanyKey が空になる企業名でも空でないキーを返すことを確認します
+func TestCompanyNameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"株式会社サンプル", "さんぷる"},
+		{"株式会社", "株式会社"},
+		{"（株）", "(株)"},
+	}
+	for _, tt := range tests {
+		if got := CompanyNameKey(tt.name); got != tt.want {
+			t.Errorf("CompanyNameKey(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
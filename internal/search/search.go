@@ -0,0 +1,184 @@
+// Package search は投稿の全文検索に使うテキスト処理を提供します
+// 日本語は単語の区切りが無いため、正規化したテキストを2文字ずつ区切った
+// バイグラムをトークンとして扱います
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize は検索用にテキストを正規化します（NFKC 正規化と小文字化）
+// 全角英数字は半角に、半角カナは全角に統一されます
+func Normalize(s string) string {
+	return strings.ToLower(norm.NFKC.String(s))
+}
+
+// Tokenize はテキストを検索用のバイグラムトークンに分割します
+// 空白で区切られた語ごとに2文字ずつのトークンを作り、重複を除いて返します
+// 1文字だけの語はそのままトークンになります
+func Tokenize(text string) []string {
+	seen := make(map[string]struct{})
+	var tokens []string
+	for _, field := range strings.FieldsFunc(Normalize(text), isSeparator) {
+		for _, token := range bigrams(field) {
+			if _, ok := seen[token]; ok {
+				continue
+			}
+			seen[token] = struct{}{}
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Strings(tokens)
+	return tokens
+}
+
+// IndexText は投稿のタイトルと本文から検索インデックス用の文字列を作成します
+// トークンを半角スペースで連結したもので、データベースの search_text カラムに保存します
+func IndexText(title, content string) string {
+	return strings.Join(Tokenize(title+" "+content), " ")
+}
+
+// ParseQuery は検索クエリを正規化し、空白区切りの検索語に分割します
+func ParseQuery(q string) []string {
+	return strings.FieldsFunc(Normalize(q), isSeparator)
+}
+
+// QueryTokens は検索語からインデックスの絞り込みに使うトークンを返します
+// 1文字の検索語はバイグラムで表現できないため含めません
+func QueryTokens(terms []string) []string {
+	var tokens []string
+	for _, term := range terms {
+		if utf8.RuneCountInString(term) < 2 {
+			continue
+		}
+		tokens = append(tokens, bigrams(term)...)
+	}
+	return tokens
+}
+
+// bigrams は語を2文字ずつのトークンに分割します
+func bigrams(field string) []string {
+	runes := []rune(field)
+	if len(runes) < 2 {
+		return []string{field}
+	}
+	tokens := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		tokens = append(tokens, string(runes[i:i+2]))
+	}
+	return tokens
+}
+
+// isSeparator はトークン分割の区切り文字かどうかを判定します
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == '　'
+}
+
+// snippetContext はスニペットでマッチ箇所の前後に含める文字数です
+const snippetContext = 40
+
+// Snippet は本文から検索語を含む部分を切り出し、マッチ箇所を <mark> で囲んだ HTML を返します
+// 本文は HTML エスケープされます。検索語が見つからない場合は本文の先頭を返します
+func Snippet(text string, terms []string) string {
+	runes := []rune(text)
+	normalized, spans := normalizeWithSpans(text)
+
+	// マッチ箇所（元テキストのルーン位置）を収集
+	var matches [][2]int
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+		start := 0
+		for {
+			i := strings.Index(normalized[start:], term)
+			if i < 0 {
+				break
+			}
+			from := spans[start+i][0]
+			to := spans[start+i+len(term)-1][1]
+			matches = append(matches, [2]int{from, to})
+			start += i + len(term)
+		}
+	}
+	matches = mergeRanges(matches)
+
+	// 最初のマッチ箇所を中心に切り出し範囲を決定
+	begin, end := 0, len(runes)
+	if len(matches) > 0 {
+		begin = max(matches[0][0]-snippetContext, 0)
+	}
+	end = min(begin+snippetContext*3, len(runes))
+
+	var b strings.Builder
+	if begin > 0 {
+		b.WriteString("…")
+	}
+	pos := begin
+	for _, m := range matches {
+		if m[1] <= begin || m[0] >= end {
+			continue
+		}
+		from, to := max(m[0], begin), min(m[1], end)
+		b.WriteString(html.EscapeString(string(runes[pos:from])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[from:to])))
+		b.WriteString("</mark>")
+		pos = to
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// normalizeWithSpans はテキスト全体を正規化した文字列と、
+// 正規化後のバイト位置ごとに対応する元テキストの範囲（ルーン位置）を返します
+// 正規化は結合文字を含む区切り（セグメント）単位で行うため、濁点などが分かれた NFD のテキストや
+// 半角カナの濁音も、まとめて正規化した文字として検索語と照合できます
+func normalizeWithSpans(text string) (string, [][2]int) {
+	var b strings.Builder
+	var spans [][2]int
+
+	var it norm.Iter
+	it.InitString(norm.NFKC, text)
+	begin, from := 0, 0
+	for !it.Done() {
+		b.WriteString(strings.ToLower(string(it.Next())))
+		// 1文字が複数の文字に展開される場合（㍻ など）は、元テキストの位置が進むまで同じ範囲に対応させる
+		if it.Pos() == begin {
+			continue
+		}
+		to := from + utf8.RuneCountInString(text[begin:it.Pos()])
+		for len(spans) < b.Len() {
+			spans = append(spans, [2]int{from, to})
+		}
+		begin, from = it.Pos(), to
+	}
+	return b.String(), spans
+}
+
+// mergeRanges は重なり合う範囲を結合し、開始位置順に並べます
+func mergeRanges(ranges [][2]int) [][2]int {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	merged := [][2]int{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1] {
+			last[1] = max(last[1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
@@ -0,0 +1,73 @@
+package search
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestTokenize は語ごとのバイグラムを正規化・重複除去して並べることを確認します
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"japanese", "面接対策", []string{"対策", "接対", "面接"}},
+		{"single rune word", "a 面接", []string{"a", "面接"}},
+		{"full-width space", "ＧＯ　言語", []string{"go", "言語"}},
+		{"duplicates", "面接 面接", []string{"面接"}},
+		{"half-width katakana", "ｶﾅ", []string{"カナ"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSnippet はマッチ箇所を <mark> で囲み、前後を省略して切り出すことを確認します
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		want  string
+	}{
+		{"no match", "一次面接の感想", []string{"最終"}, "一次面接の感想"},
+		{"match", "一次面接の感想", []string{"面接"}, "一次<mark>面接</mark>の感想"},
+		{"normalized match", "ＧＯの面接", []string{"go"}, "<mark>ＧＯ</mark>の面接"},
+		{"overlapping terms", "一次面接", []string{"一次面", "面接"}, "<mark>一次面接</mark>"},
+		{"escape", "<b>面接</b>", []string{"面接"}, "&lt;b&gt;<mark>面接</mark>&lt;/b&gt;"},
+		{"empty term", "面接", []string{""}, "面接"},
+		{"nfd match", "企業のか\u3099いよう", []string{"がいよう"}, "企業の<mark>か\u3099いよう</mark>"},
+		{"halfwidth voiced match", "ｶﾞｲﾀﾞﾝｽに参加", []string{"ガイダンス"}, "<mark>ｶﾞｲﾀﾞﾝｽ</mark>に参加"},
+		{"partial expansion", "㍻の面接", []string{"平"}, "<mark>㍻</mark>の面接"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Snippet(tt.text, tt.terms); got != tt.want {
+				t.Errorf("Snippet(%q, %q) = %q, want %q", tt.text, tt.terms, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSnippetTruncate は長い本文をマッチ箇所の前後で切り出すことを確認します
+func TestSnippetTruncate(t *testing.T) {
+	text := strings.Repeat("あ", 100) + "面接" + strings.Repeat("い", 200)
+	got := Snippet(text, []string{"面接"})
+
+	want := "…" + strings.Repeat("あ", snippetContext) + "<mark>面接</mark>" + strings.Repeat("い", snippetContext*2-2) + "…"
+	if got != want {
+		t.Errorf("Snippet() = %q, want %q", got, want)
+	}
+
+	// マッチしない場合は先頭を切り出す
+	if got := Snippet(strings.Repeat("あ", 200), []string{"面接"}); got != strings.Repeat("あ", snippetContext*3)+"…" {
+		t.Errorf("Snippet() without match = %q", got)
+	}
+}
@@ -0,0 +1,185 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// matchKind は候補が一致した箇所の種類です（値が小さいほど優先）
+type matchKind int
+
+const (
+	matchName matchKind = iota
+	matchKana
+	matchAlias
+)
+
+// SuggestEntry は補完候補として登録する企業です
+type SuggestEntry struct {
+	ID        uint
+	Name      string
+	Kana      string
+	Aliases   []string
+	PostCount int64
+}
+
+// Suggestion は補完候補の検索結果です
+type Suggestion struct {
+	ID        uint
+	Name      string
+	Kana      string
+	Matched   string // 別名で一致した場合の別名
+	PostCount int64
+}
+
+// indexKey は前方一致検索用のキーです
+type indexKey struct {
+	key     string
+	kind    matchKind
+	entryID uint
+	text    string
+}
+
+// SuggestIndex は企業名の前方一致検索を行うインメモリのインデックスです
+// 企業名・読み・別名を CompanyKey で正規化したキーを整列して保持し、二分探索で候補を探します
+// 複数のゴルーチンから安全に利用できます
+type SuggestIndex struct {
+	mu      sync.RWMutex
+	entries map[uint]*SuggestEntry
+	keys    []indexKey
+}
+
+// NewSuggestIndex は空の SuggestIndex を作成します
+func NewSuggestIndex() *SuggestIndex {
+	return &SuggestIndex{entries: make(map[uint]*SuggestEntry)}
+}
+
+// Replace はインデックスの内容をすべて置き換えます
+func (x *SuggestIndex) Replace(entries []SuggestEntry) {
+	m := make(map[uint]*SuggestEntry, len(entries))
+	var keys []indexKey
+	for i := range entries {
+		entry := entries[i]
+		m[entry.ID] = &entry
+		keys = append(keys, entryKeys(&entry)...)
+	}
+	sortKeys(keys)
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.entries = m
+	x.keys = keys
+}
+
+// RecordPost は企業に投稿が追加されたことをインデックスに反映します
+// 未登録の企業は新しい候補として追加されます
+func (x *SuggestIndex) RecordPost(id uint, name string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if entry, ok := x.entries[id]; ok {
+		entry.PostCount++
+		return
+	}
+
+	entry := &SuggestEntry{ID: id, Name: name, PostCount: 1}
+	x.entries[id] = entry
+	for _, k := range entryKeys(entry) {
+		i := sort.Search(len(x.keys), func(i int) bool { return !keyLess(x.keys[i], k) })
+		x.keys = append(x.keys, indexKey{})
+		copy(x.keys[i+1:], x.keys[i:])
+		x.keys[i] = k
+	}
+}
+
+// RemovePost は企業の投稿が削除・非表示になったこと、または別の企業に変更されたことをインデックスに反映します
+// 投稿の無くなった企業も候補として残します
+func (x *SuggestIndex) RemovePost(id uint) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if entry, ok := x.entries[id]; ok && entry.PostCount > 0 {
+		entry.PostCount--
+	}
+}
+
+// Suggest はクエリに前方一致する企業を最大 limit 件返します
+// 企業名・読み・別名の順に優先し、同じ優先度では投稿数の多い順に並べます
+func (x *SuggestIndex) Suggest(q string, limit int) []Suggestion {
+	prefix := CompanyKey(q)
+	if prefix == "" || limit <= 0 {
+		return []Suggestion{}
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	// 企業ごとに最も優先度の高い一致を採用
+	best := make(map[uint]indexKey)
+	start := sort.Search(len(x.keys), func(i int) bool { return x.keys[i].key >= prefix })
+	for i := start; i < len(x.keys) && strings.HasPrefix(x.keys[i].key, prefix); i++ {
+		k := x.keys[i]
+		if cur, ok := best[k.entryID]; !ok || k.kind < cur.kind {
+			best[k.entryID] = k
+		}
+	}
+
+	matches := make([]indexKey, 0, len(best))
+	for _, k := range best {
+		matches = append(matches, k)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		ea, eb := x.entries[a.entryID], x.entries[b.entryID]
+		if ea.PostCount != eb.PostCount {
+			return ea.PostCount > eb.PostCount
+		}
+		return ea.Name < eb.Name
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	suggestions := make([]Suggestion, len(matches))
+	for i, k := range matches {
+		entry := x.entries[k.entryID]
+		suggestions[i] = Suggestion{
+			ID:        entry.ID,
+			Name:      entry.Name,
+			Kana:      entry.Kana,
+			PostCount: entry.PostCount,
+		}
+		if k.kind == matchAlias {
+			suggestions[i].Matched = k.text
+		}
+	}
+	return suggestions
+}
+
+// entryKeys は企業の企業名・読み・別名から検索キーを作成します
+func entryKeys(entry *SuggestEntry) []indexKey {
+	keys := []indexKey{{key: CompanyKey(entry.Name), kind: matchName, entryID: entry.ID, text: entry.Name}}
+	if entry.Kana != "" {
+		keys = append(keys, indexKey{key: CompanyKey(entry.Kana), kind: matchKana, entryID: entry.ID, text: entry.Kana})
+	}
+	for _, alias := range entry.Aliases {
+		keys = append(keys, indexKey{key: CompanyKey(alias), kind: matchAlias, entryID: entry.ID, text: alias})
+	}
+	return keys
+}
+
+// sortKeys は検索キーを前方一致検索できる順序に並べます
+func sortKeys(keys []indexKey) {
+	sort.Slice(keys, func(i, j int) bool { return keyLess(keys[i], keys[j]) })
+}
+
+func keyLess(a, b indexKey) bool {
+	if a.key != b.key {
+		return a.key < b.key
+	}
+	return a.entryID < b.entryID
+}
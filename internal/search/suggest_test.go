@@ -0,0 +1,34 @@
+package search
+
+import "testing"
+
+// TestSuggestIndexPostCount は投稿の追加・削除を投稿数に反映し、投稿数の多い順に並べることを確認します
+func TestSuggestIndexPostCount(t *testing.T) {
+	x := NewSuggestIndex()
+	x.Replace([]SuggestEntry{
+		{ID: 1, Name: "サンプル商事", PostCount: 2},
+		{ID: 2, Name: "サンプル工業", PostCount: 1},
+	})
+
+	x.RecordPost(2, "サンプル工業")
+	x.RecordPost(2, "サンプル工業")
+	x.RemovePost(1)
+	if got := x.Suggest("さんぷる", 10); len(got) != 2 || got[0].ID != 2 || got[0].PostCount != 3 || got[1].PostCount != 1 {
+		t.Fatalf("Suggest() = %+v, want company 2 (3 posts) before company 1 (1 post)", got)
+	}
+
+	// 投稿数は0未満にならず、投稿の無くなった企業も候補に残る
+	x.RemovePost(1)
+	x.RemovePost(1)
+	got := x.Suggest("さんぷる商", 10)
+	if len(got) != 1 || got[0].PostCount != 0 {
+		t.Errorf("Suggest() = %+v, want company 1 with 0 posts", got)
+	}
+
+	// 未登録の企業は投稿の追加時に候補になる
+	x.RemovePost(3)
+	x.RecordPost(3, "テスト")
+	if got := x.Suggest("てす", 10); len(got) != 1 || got[0].ID != 3 || got[0].PostCount != 1 {
+		t.Errorf("Suggest() = %+v, want company 3 with 1 post", got)
+	}
+}
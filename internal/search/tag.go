@@ -0,0 +1,21 @@
+package search
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// CleanTag はタグの表示名を整えます
+// NFKC 正規化の後、先頭の「#」を取り除き、連続する空白を1つにまとめます
+func CleanTag(name string) string {
+	s := strings.TrimLeft(strings.TrimSpace(norm.NFKC.String(name)), "#")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// TagKey はタグの表記ゆれを吸収した比較用のキーを返します
+// 全角・半角や大文字・小文字の違い、空白の有無、カタカナとひらがなの違いを区別しません
+func TagKey(name string) string {
+	s := strings.ToLower(CleanTag(name))
+	return ToHiragana(strings.Join(strings.Fields(s), ""))
+}
@@ -0,0 +1,277 @@
+// Package seed は開発環境向けのデモデータを生成・投入します
+// 同じシードと設定からは常に同じデータを生成するため、テストやスクリーンショットを再現できます
+package seed
+
+import (
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+)
+
+// maxReplyDepth は生成する返信の最大の深さです（サービス層の上限より浅くする）
+const maxReplyDepth = 2
+
+// location は投稿日時を決めるタイムゾーンです
+var location = time.FixedZone("JST", 9*60*60)
+
+// Options は生成するデータの件数などの設定です
+type Options struct {
+	Seed        uint64     // 乱数のシード
+	Posts       int        // 投稿数
+	MaxComments int        // 1投稿あたりの最大コメント数
+	Days        int        // 投稿日時を分散させる日数
+	Until       time.Time  // これより後の日時の投稿・コメントは生成しない
+	Categories  []Category // 投稿に使用するカテゴリ（順に割り当てる）
+}
+
+// Category は投稿に使用するカテゴリです
+type Category struct {
+	Name      string
+	Interview bool // 面接情報を登録できるカテゴリ（面接の文面を使い、面接情報を付ける）
+}
+
+// defaultCategory は Categories が空の場合に使用するカテゴリです
+var defaultCategory = Category{Name: "その他"}
+
+// Post は生成した投稿です
+type Post struct {
+	Request   models.PostCreateRequest
+	CreatedAt time.Time
+	Comments  []Comment // 作成日時の昇順
+}
+
+// Comment は生成したコメントです
+type Comment struct {
+	Parent    int // 返信先のコメント（同じ投稿の Comments の添字、トップレベルは -1）
+	Content   string
+	CreatedAt time.Time
+}
+
+// generator はデモデータの生成に使用する乱数と設定です
+type generator struct {
+	r         *rand.Rand
+	opts      Options
+	companies []string
+}
+
+// Generate は設定に従って投稿とコメントを作成日時の昇順で生成します
+func Generate(opts Options) []Post {
+	g := &generator{
+		r:    rand.New(rand.NewPCG(opts.Seed, opts.Seed)),
+		opts: opts,
+	}
+	g.companies = g.companyNames(max(10, opts.Posts/4))
+
+	posts := make([]Post, 0, opts.Posts)
+	for i := 0; i < opts.Posts; i++ {
+		category := defaultCategory
+		if len(opts.Categories) > 0 {
+			category = opts.Categories[i%len(opts.Categories)]
+		}
+		posts = append(posts, g.post(category))
+	}
+
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].CreatedAt.Before(posts[j].CreatedAt)
+	})
+	return posts
+}
+
+// companyNames は重複しない架空の企業名を n 件生成します
+func (g *generator) companyNames(n int) []string {
+	n = min(n, len(companyPrefixes)*len(companySuffixes))
+	seen := make(map[string]bool, n)
+	names := make([]string, 0, n)
+	for len(names) < n {
+		name := pick(g.r, companyPrefixes) + pick(g.r, companySuffixes)
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// post はカテゴリの文面から投稿を1件生成します
+func (g *generator) post(category Category) Post {
+	tmpl, ok := postTemplates[category.Name]
+	if category.Interview {
+		tmpl = interviewTemplate
+	} else if !ok {
+		tmpl = otherTemplate
+	}
+
+	vars := map[string]string{
+		"company": pick(g.r, g.companies),
+		"job":     pick(g.r, jobTypes),
+		"stage":   pick(g.r, interviewStages),
+	}
+
+	// 本文は書き出し・中ほどの文（2〜4文）・締めの文を連結する
+	sentences := []string{g.fill(pick(g.r, tmpl.openers), vars)}
+	for _, i := range g.r.Perm(len(tmpl.bodies))[:2+g.r.IntN(3)] {
+		sentences = append(sentences, g.fill(tmpl.bodies[i], vars))
+	}
+	sentences = append(sentences, g.fill(pick(g.r, tmpl.closings), vars))
+
+	createdAt := g.postTime()
+	req := models.PostCreateRequest{
+		Title:       g.fill(pick(g.r, tmpl.titles), vars),
+		Content:     strings.Join(sentences, ""),
+		Category:    category.Name,
+		CompanyName: vars["company"],
+		JobType:     vars["job"],
+		Tags:        g.tags(tmpl.tags),
+	}
+	if category.Interview {
+		req.Interview = g.interview(vars["stage"], createdAt)
+	}
+
+	return Post{
+		Request:   req,
+		CreatedAt: createdAt,
+		Comments:  g.comments(tmpl, createdAt),
+	}
+}
+
+// fill は文面中の {name} を置き換えます
+// 投稿ごとに決まる値は vars から、それ以外は出現するたびに新しい値を生成します
+func (g *generator) fill(s string, vars map[string]string) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(s, '{')
+		if start < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		b.WriteString(s[:start])
+		b.WriteString(g.value(s[start+1:start+end], vars))
+		s = s[start+end+1:]
+	}
+}
+
+// value はプレースホルダーの値を返します
+func (g *generator) value(name string, vars map[string]string) string {
+	if v, ok := vars[name]; ok {
+		return v
+	}
+	switch name {
+	case "question":
+		return pick(g.r, esQuestions)
+	case "chars":
+		return strconv.Itoa(200 * (1 + g.r.IntN(3)))
+	case "month":
+		return strconv.Itoa(1 + g.r.IntN(12))
+	case "day":
+		return strconv.Itoa(1 + g.r.IntN(28))
+	case "rounds":
+		return strconv.Itoa(2 + g.r.IntN(3))
+	case "pay":
+		return strconv.Itoa(22 + g.r.IntN(10))
+	case "months":
+		return strconv.Itoa(1 + g.r.IntN(6))
+	}
+	return name
+}
+
+// tags はタグ候補から0〜3件を選びます
+func (g *generator) tags(candidates []string) []string {
+	n := g.r.IntN(4)
+	tags := make([]string, 0, n)
+	for _, i := range g.r.Perm(len(candidates))[:n] {
+		tags = append(tags, candidates[i])
+	}
+	return tags
+}
+
+// interview は面接情報を生成します（面接日は投稿の0〜7日前）
+func (g *generator) interview(stage string, postedAt time.Time) *models.PostInterviewRequest {
+	minutes := pick(g.r, interviewMinutes)
+	interviewers := 1 + g.r.IntN(3)
+	interviewedOn := postedAt.In(location).AddDate(0, 0, -g.r.IntN(8))
+
+	// 最近の面接ほど結果待ちが多くなるようにする
+	outcome := pick(g.r, interviewOutcomes)
+	if g.opts.Until.Sub(postedAt) < 7*24*time.Hour && g.r.IntN(2) == 0 {
+		outcome = models.InterviewOutcomePending
+	}
+
+	return &models.PostInterviewRequest{
+		Stage:            stage,
+		Format:           pick(g.r, interviewFormats),
+		DurationMinutes:  &minutes,
+		InterviewerCount: &interviewers,
+		Outcome:          outcome,
+		InterviewedOn:    interviewedOn.Format("2006-01-02"),
+	}
+}
+
+// postTime は直近 Days 日間の8時〜24時（日本時間）の日時を返します
+func (g *generator) postTime() time.Time {
+	until := g.opts.Until.In(location)
+	days := max(1, g.opts.Days)
+	day := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, location).
+		AddDate(0, 0, -g.r.IntN(days))
+	t := day.Add(8*time.Hour + time.Duration(g.r.IntN(16*60*60))*time.Second)
+	if t.After(until) {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t
+}
+
+// comments は投稿へのコメントのスレッドを生成します
+// コメントは直前のコメント（最初のコメントは投稿）の数分〜数時間後に投稿されたものとし、Until より後のコメントは生成しない
+func (g *generator) comments(tmpl postTemplate, postedAt time.Time) []Comment {
+	if g.opts.MaxComments <= 0 {
+		return nil
+	}
+
+	n := g.r.IntN(g.opts.MaxComments + 1)
+	comments := make([]Comment, 0, n)
+	depths := make([]int, 0, n)
+	at := postedAt
+	for i := 0; i < n; i++ {
+		at = at.Add(5*time.Minute + time.Duration(g.r.ExpFloat64()*float64(6*time.Hour))).Truncate(time.Second)
+		if at.After(g.opts.Until) {
+			break
+		}
+
+		comment := Comment{Parent: -1, CreatedAt: at}
+		depth := 0
+		if i > 0 && g.r.IntN(3) == 0 {
+			// 直近のコメントほど返信されやすくする
+			parent := i - 1 - g.r.IntN(min(i, 3))
+			if depths[parent] < maxReplyDepth {
+				comment.Parent = parent
+				depth = depths[parent] + 1
+			}
+		}
+
+		switch {
+		case comment.Parent >= 0:
+			comment.Content = pick(g.r, replyComments)
+		case g.r.IntN(3) == 0:
+			comment.Content = pick(g.r, tmpl.questions)
+		default:
+			comment.Content = pick(g.r, rootComments)
+		}
+
+		comments = append(comments, comment)
+		depths = append(depths, depth)
+	}
+	return comments
+}
+
+// pick は候補から1件を選びます
+func pick[T any](r *rand.Rand, candidates []T) T {
+	return candidates[r.IntN(len(candidates))]
+}
@@ -0,0 +1,64 @@
+package seed
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testOptions はテストで使用する生成の設定です
+func testOptions(seed uint64) Options {
+	return Options{
+		Seed:        seed,
+		Posts:       50,
+		MaxComments: 8,
+		Days:        30,
+		Until:       time.Date(2025, 4, 1, 12, 0, 0, 0, location),
+		Categories:  []Category{{Name: "面接", Interview: true}, {Name: "ES"}, {Name: "企業情報"}, {Name: "その他"}},
+	}
+}
+
+// TestGenerateDeterministic は同じ設定からは常に同じデータを生成することを確認します
+func TestGenerateDeterministic(t *testing.T) {
+	first := Generate(testOptions(1))
+	second := Generate(testOptions(1))
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("Generate returned different posts for the same options")
+	}
+
+	if reflect.DeepEqual(first, Generate(testOptions(2))) {
+		t.Error("Generate returned the same posts for different seeds")
+	}
+}
+
+// TestGenerateOrder は投稿・コメントが作成日時の昇順で、Until より後のものが無いことを確認します
+func TestGenerateOrder(t *testing.T) {
+	opts := testOptions(1)
+	posts := Generate(opts)
+	if len(posts) != opts.Posts {
+		t.Fatalf("got %d posts, want %d", len(posts), opts.Posts)
+	}
+
+	for i, post := range posts {
+		if i > 0 && post.CreatedAt.Before(posts[i-1].CreatedAt) {
+			t.Errorf("post %d was created before the previous post", i)
+		}
+		if post.CreatedAt.After(opts.Until) {
+			t.Errorf("post %d was created after Until: %v", i, post.CreatedAt)
+		}
+		if len(post.Comments) > opts.MaxComments {
+			t.Errorf("post %d has %d comments, want at most %d", i, len(post.Comments), opts.MaxComments)
+		}
+
+		prev := post.CreatedAt
+		for j, comment := range post.Comments {
+			if !comment.CreatedAt.After(prev) || comment.CreatedAt.After(opts.Until) {
+				t.Errorf("post %d comment %d has an invalid time: %v", i, j, comment.CreatedAt)
+			}
+			if comment.Parent < -1 || comment.Parent >= j {
+				t.Errorf("post %d comment %d replies to %d", i, j, comment.Parent)
+			}
+			prev = comment.CreatedAt
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package seed
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/services"
+)
+
+// Clock は投入するデータの作成日時を制御する時計です
+// gorm.Session の NowFunc に Now を指定したデータベースでサービスを初期化して使用します
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock は指定した日時を指す Clock を作成します
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now は現在設定されている日時を返します
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set は日時を変更します
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
+
+// Result は投入したデータの件数です
+type Result struct {
+	Posts    int
+	Comments int
+}
+
+// Run は生成した投稿・コメントをサービス層を経由して作成します
+// 作成前に clock を各データの作成日時に合わせるため、通常の投稿と同じバリデーションを通したうえで日時を再現できます
+func Run(posts []Post, clock *Clock, postService services.PostService, commentService services.CommentService) (Result, error) {
+	var result Result
+	for i := range posts {
+		post := &posts[i]
+
+		clock.Set(post.CreatedAt)
+		created, err := postService.CreatePost(&post.Request)
+		if err != nil {
+			return result, fmt.Errorf("failed to create post %q: %w", post.Request.Title, err)
+		}
+		result.Posts++
+
+		ids := make([]uint, len(post.Comments))
+		for j, comment := range post.Comments {
+			req := &models.CommentCreateRequest{
+				PostID:  created.ID,
+				Content: comment.Content,
+			}
+			if comment.Parent >= 0 {
+				req.ParentID = &ids[comment.Parent]
+			}
+
+			clock.Set(comment.CreatedAt)
+			createdComment, err := commentService.CreateComment(req)
+			if err != nil {
+				return result, fmt.Errorf("failed to create comment on post %d: %w", created.ID, err)
+			}
+			ids[j] = createdComment.ID
+			result.Comments++
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,192 @@
+package seed
+
+import "github.com/latttchc/finding-forest-backend/internal/models"
+
+// 企業名は架空の名前を「接頭語＋業種」の組み合わせで生成する
+var (
+	companyPrefixes = []string{
+		"東都", "北斗", "青葉", "さくら", "みなと", "大和", "日の出", "富士見",
+		"若葉", "高輪", "みらい", "アオゾラ", "ひかり", "瀬戸内", "千代田", "ミドリ",
+	}
+	companySuffixes = []string{
+		"商事", "システムズ", "テクノロジー", "銀行", "製薬", "電機", "ソリューションズ", "不動産",
+		"食品", "ホールディングス", "証券", "物産", "化学", "通信", "マーケティング", "コンサルティング",
+	}
+)
+
+// jobTypes は投稿に設定する職種
+var jobTypes = []string{
+	"エンジニア", "総合職", "営業", "企画", "データサイエンティスト",
+	"デザイナー", "研究職", "コンサルタント", "事務職", "マーケティング",
+}
+
+// postTemplate はカテゴリごとの投稿の文面
+// タイトル・本文中の {company}・{job}・{stage} などは生成時に置き換える
+type postTemplate struct {
+	titles    []string
+	openers   []string // 本文の1文目
+	bodies    []string // 本文の中ほどの文（複数選ぶ）
+	closings  []string // 本文の最後の文
+	tags      []string
+	questions []string // 投稿に対する質問のコメント
+}
+
+// postTemplates はカテゴリ名ごとの文面（該当しないカテゴリは otherTemplate を使う）
+var postTemplates = map[string]postTemplate{
+	"ES": {
+		titles: []string{
+			"{company} {job} ESの設問まとめ",
+			"{company}のES、締切と文字数について",
+			"{company}（{job}）のESを提出しました",
+		},
+		openers: []string{
+			"{company}の{job}職のESについてまとめます。",
+			"設問は「{question}」（{chars}字）と「{question}」（{chars}字）の2問でした。",
+		},
+		bodies: []string{
+			"提出はマイページからで、締切は{month}月{day}日でした。",
+			"書類選考の結果は2週間ほどで届きました。",
+			"結論から書くことを意識しました。",
+			"具体的な数字を入れるようにしました。",
+			"大学のキャリアセンターで添削してもらいました。",
+			"Webテストも同時に受検する必要がありました。",
+		},
+		closings: []string{
+			"通過できたので参考までに共有します。",
+			"同じ企業を受ける方の参考になれば嬉しいです。",
+		},
+		tags: []string{"ES", "志望動機", "本選考", "インターン", "Webテスト"},
+		questions: []string{
+			"締切はいつ頃でしたか？",
+			"文字数はぎりぎりまで書きましたか？",
+			"Webテストの種類は何でしたか？",
+		},
+	},
+	"企業情報": {
+		titles: []string{
+			"{company}の社風について",
+			"{company}（{job}）の待遇・働き方",
+			"{company}の選考フローまとめ",
+		},
+		openers: []string{
+			"説明会で聞いた{company}の情報をまとめます。",
+			"{company}のインターンに参加して感じたことです。",
+		},
+		bodies: []string{
+			"選考フローはES、Webテスト、面接{rounds}回でした。",
+			"初任給は月{pay}万円程度とのことです。",
+			"リモートワークと出社を併用している部署が多いそうです。",
+			"研修期間は{months}か月で、配属は本人の希望も考慮されるそうです。",
+			"若手にも裁量がある一方、忙しい時期は残業もあると聞きました。",
+			"社員の方が気さくで質問しやすい雰囲気でした。",
+		},
+		closings: []string{
+			"気になる方の参考になれば幸いです。",
+			"ほかにも情報があれば教えてください。",
+		},
+		tags: []string{"社風", "待遇", "選考フロー", "インターン", "説明会"},
+		questions: []string{
+			"配属先の希望はどのくらい通るのでしょうか？",
+			"住宅手当はありましたか？",
+			"説明会はオンラインでしたか？",
+		},
+	},
+}
+
+// interviewTemplate は面接のカテゴリ（Category.Interview）の文面
+var interviewTemplate = postTemplate{
+	titles: []string{
+		"{company} {stage}面接の体験談",
+		"{company}（{job}）{stage}面接で聞かれたこと",
+		"{company}の{stage}面接の雰囲気と逆質問について",
+	},
+	openers: []string{
+		"{job}職の{stage}面接を受けてきました。",
+		"{company}の{stage}面接について共有します。",
+	},
+	bodies: []string{
+		"学生時代に力を入れたことを深掘りされました。",
+		"志望動機と、他社の選考状況を聞かれました。",
+		"チームで意見が対立したときの対応について質問されました。",
+		"これまでに作ったものの設計について説明を求められました。",
+		"入社後に挑戦したいことを具体的に聞かれました。",
+		"挫折した経験とそこから学んだことを聞かれました。",
+		"面接官の方は穏やかで話しやすい雰囲気でした。",
+		"圧迫感はありませんでしたが、回答の根拠を何度も聞かれました。",
+		"最後に逆質問の時間が10分ほどありました。",
+	},
+	closings: []string{
+		"結果は1週間後にメールで連絡がありました。",
+		"これから受ける方の参考になれば嬉しいです。",
+		"次の選考に向けて準備を進めています。",
+	},
+	tags: []string{"逆質問", "ガクチカ", "オンライン面接", "本選考", "インターン"},
+	questions: []string{
+		"逆質問は何個くらい用意していきましたか？",
+		"面接官は何人でしたか？",
+		"結果の連絡はどのくらいで来ましたか？",
+	},
+}
+
+// otherTemplate はその他のカテゴリの文面
+var otherTemplate = postTemplate{
+	titles: []string{
+		"就活の進め方について相談です",
+		"{job}志望の方、自己分析はどうしていますか",
+		"Webテストの対策方法",
+		"内定承諾の期限について",
+	},
+	openers: []string{
+		"{job}志望の大学3年生です。",
+		"就活について相談させてください。",
+	},
+	bodies: []string{
+		"周りの友人と比べて進みが遅い気がして不安です。",
+		"おすすめの対策本やサイトがあれば教えてください。",
+		"インターンに参加していないと不利なのでしょうか。",
+		"自己分析と業界研究はどちらを先に進めるのがよいでしょうか。",
+		"説明会にはどのくらい参加しましたか。",
+	},
+	closings: []string{
+		"同じような状況の方がいれば情報交換したいです。",
+		"よろしくお願いします。",
+	},
+	tags: []string{"自己分析", "Webテスト", "就活相談", "内定", "業界研究"},
+	questions: []string{
+		"自分も同じことで悩んでいます。",
+		"何月頃から始めましたか？",
+	},
+}
+
+// 面接情報に設定する値
+var (
+	interviewStages   = []string{models.InterviewStageFirst, models.InterviewStageSecond, models.InterviewStageFinal}
+	interviewFormats  = []string{models.InterviewFormatOnline, models.InterviewFormatOnsite}
+	interviewOutcomes = []string{models.InterviewOutcomePassed, models.InterviewOutcomeFailed, models.InterviewOutcomePending}
+	interviewMinutes  = []int{30, 45, 60, 90}
+	esQuestions       = []string{
+		"学生時代に最も力を入れたこと", "当社を志望する理由", "あなたの強みと弱み",
+		"チームで成果を出した経験", "10年後に実現したいこと", "最近関心を持ったニュース",
+	}
+)
+
+// コメントの文面
+var (
+	rootComments = []string{
+		"参考になります！ありがとうございます。",
+		"自分も同じ質問をされました。",
+		"とても詳しくて助かります。",
+		"私の時は雰囲気がかなり違いました。",
+		"同じ企業を受ける予定なので参考にします。",
+		"情報ありがとうございます、準備を頑張ります。",
+	}
+	replyComments = []string{
+		"ありがとうございます！",
+		"3つほど用意していきました。",
+		"1週間くらいで連絡が来ました。",
+		"2人でした。",
+		"そうなんですね、参考になります。",
+		"部署によって違うのかもしれませんね。",
+		"自分も気になっていました。",
+	}
+)
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrInvalidCredentials はユーザー名またはパスワードが一致しない場合のエラーです
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	// ErrInvalidSession はセッショントークンが無効または期限切れの場合のエラーです
+	ErrInvalidSession = errors.New("invalid or expired session")
+	// ErrModeratorExists は同じユーザー名のモデレーターが既に存在する場合のエラーです
+	ErrModeratorExists = errors.New("moderator already exists")
+)
+
+// AuthService はモデレーターの認証に関するビジネスロジックを定義するインターフェースです
+type AuthService interface {
+	CreateModerator(req *models.ModeratorCreateRequest) (*models.ModeratorResponse, error)
+	Login(req *models.ModeratorLoginRequest) (*models.ModeratorLoginResponse, error)
+	Logout(moderatorID uint, token string) error
+	Authenticate(token string) (*models.Moderator, error)
+}
+
+// authService は AuthService インターフェースの実装です
+type authService struct {
+	moderatorRepo repositories.ModeratorRepository // モデレーターデータアクセス層
+	auditLogRepo  repositories.AuditLogRepository  // 監査ログデータアクセス層
+	validator     *validator.Validate              // バリデーター
+	sessionTTL    time.Duration                    // セッションの有効期間
+}
+
+// NewAuthService は新しい AuthService インスタンスを作成します
+func NewAuthService(moderatorRepo repositories.ModeratorRepository, auditLogRepo repositories.AuditLogRepository, validator *validator.Validate, sessionTTL time.Duration) AuthService {
+	return &authService{
+		moderatorRepo: moderatorRepo,
+		auditLogRepo:  auditLogRepo,
+		validator:     validator,
+		sessionTTL:    sessionTTL,
+	}
+}
+
+// CreateModerator は新しいモデレーターを作成します
+// パスワードは bcrypt でハッシュ化して保存します
+func (s *authService) CreateModerator(req *models.ModeratorCreateRequest) (*models.ModeratorResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	// ユーザー名の重複チェック
+	_, err := s.moderatorRepo.GetByUsername(req.Username)
+	if err == nil {
+		return nil, ErrModeratorExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, failedTo("check moderator", err)
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, failedTo("hash password", err)
+	}
+
+	moderator := &models.Moderator{
+		Username:     req.Username,
+		PasswordHash: string(passwordHash),
+	}
+
+	if err := s.moderatorRepo.Create(moderator); err != nil {
+		return nil, failedTo("create moderator", err)
+	}
+
+	response := newModeratorResponse(moderator)
+	return &response, nil
+}
+
+// Login はユーザー名とパスワードを検証し、新しいセッションを発行します
+// セッショントークンはレスポンスでのみ返し、データベースにはハッシュ値を保存します
+func (s *authService) Login(req *models.ModeratorLoginRequest) (*models.ModeratorLoginResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	moderator, err := s.moderatorRepo.GetByUsername(req.Username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, failedTo("get moderator", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(moderator.PasswordHash), []byte(req.Password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	// セッションを発行
+	token, tokenHash, err := generateToken()
+	if err != nil {
+		return nil, failedTo("generate session token", err)
+	}
+
+	now := time.Now()
+	session := &models.ModeratorSession{
+		ModeratorID: moderator.ID,
+		TokenHash:   tokenHash,
+		ExpiresAt:   now.Add(s.sessionTTL),
+	}
+	if err := s.moderatorRepo.CreateSession(session); err != nil {
+		return nil, failedTo("create session", err)
+	}
+
+	// 期限切れのセッションを掃除（失敗してもログインは継続）
+	_ = s.moderatorRepo.DeleteExpiredSessions()
+
+	if err := s.moderatorRepo.UpdateLastLogin(moderator.ID, now); err != nil {
+		return nil, failedTo("update last login", err)
+	}
+	moderator.LastLoginAt = &now
+
+	if err := s.auditLogRepo.Create(&models.AuditLog{
+		ModeratorID: moderator.ID,
+		Action:      models.AuditActionLogin,
+	}); err != nil {
+		return nil, failedTo("write audit log", err)
+	}
+
+	return &models.ModeratorLoginResponse{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+		Moderator: newModeratorResponse(moderator),
+	}, nil
+}
+
+// Logout はセッションを無効化します
+func (s *authService) Logout(moderatorID uint, token string) error {
+	if err := s.moderatorRepo.DeleteSession(hashToken(token)); err != nil {
+		return failedTo("delete session", err)
+	}
+
+	if err := s.auditLogRepo.Create(&models.AuditLog{
+		ModeratorID: moderatorID,
+		Action:      models.AuditActionLogout,
+	}); err != nil {
+		return failedTo("write audit log", err)
+	}
+
+	return nil
+}
+
+// Authenticate はセッショントークンからモデレーターを取得します
+func (s *authService) Authenticate(token string) (*models.Moderator, error) {
+	if token == "" {
+		return nil, ErrInvalidSession
+	}
+
+	session, err := s.moderatorRepo.GetSessionByTokenHash(hashToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidSession
+		}
+		return nil, failedTo("get session", err)
+	}
+
+	return &session.Moderator, nil
+}
+
+// newModeratorResponse はモデレーターモデルをレスポンス形式に変換します
+func newModeratorResponse(moderator *models.Moderator) models.ModeratorResponse {
+	return models.ModeratorResponse{
+		ID:          moderator.ID,
+		Username:    moderator.Username,
+		LastLoginAt: moderator.LastLoginAt,
+		CreatedAt:   moderator.CreatedAt,
+	}
+}
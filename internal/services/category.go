@@ -0,0 +1,42 @@
+package services
+
+import (
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+)
+
+// CategoryService はカテゴリに関するビジネスロジックを定義するインターフェースです
+type CategoryService interface {
+	GetCategories() ([]models.CategoryResponse, error)
+}
+
+// categoryService は CategoryService インターフェースの実装です
+type categoryService struct {
+	categoryRepo repositories.CategoryRepository // カテゴリデータアクセス層
+}
+
+// NewCategoryService は新しい CategoryService インスタンスを作成します
+func NewCategoryService(categoryRepo repositories.CategoryRepository) CategoryService {
+	return &categoryService{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// GetCategories は投稿で選択できるカテゴリを表示順に取得します
+func (s *categoryService) GetCategories() ([]models.CategoryResponse, error) {
+	categories, err := s.categoryRepo.GetActive()
+	if err != nil {
+		return nil, failedTo("get categories", err)
+	}
+
+	responses := make([]models.CategoryResponse, len(categories))
+	for i, category := range categories {
+		responses[i] = models.CategoryResponse{
+			Name:         category.Name,
+			Description:  category.Description,
+			DisplayOrder: category.DisplayOrder,
+			IsInterview:  category.IsInterview,
+		}
+	}
+	return responses, nil
+}
@@ -1,32 +1,52 @@
 package services
 
 import (
-	"fmt"
+	"errors"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
 	"github.com/latttchc/finding-forest-backend/internal/repositories"
 )
 
+var (
+	// ErrCommentDepthExceeded は返信のネストが上限を超えた場合のエラーです
+	ErrCommentDepthExceeded = errors.New("reply depth limit exceeded")
+	// ErrParentCommentMismatch は返信先コメントが別の投稿に属している場合のエラーです
+	ErrParentCommentMismatch = errors.New("parent comment belongs to another post")
+	// ErrParentCommentNotFound は返信先のコメントが存在しない場合のエラーです
+	ErrParentCommentNotFound = errors.New("parent comment not found")
+)
+
 // CommentService はコメントに関するビジネスロジックを定義するインターフェースです
 type CommentService interface {
 	CreateComment(req *models.CommentCreateRequest) (*models.CommentResponse, error)
-	GetCommentsByPostID(postID uint) ([]models.CommentResponse, error)
+	GetCommentsByPostID(postID uint, sort string, limit int, cursor *pagination.Cursor) (*CommentListResult, error)
+	UpdateComment(id uint, editToken string, req *models.CommentUpdateRequest) (*models.CommentResponse, error)
+	DeleteComment(id uint, editToken string) error
+}
+
+// CommentListResult はコメント一覧取得の結果を表す構造体です
+type CommentListResult struct {
+	Comments   []models.CommentResponse `json:"comments"`              // 返信ツリー形式のコメント一覧
+	NextCursor string                   `json:"next_cursor,omitempty"` // 続きを取得するカーソル（続きがない場合は空）
 }
 
 // commentService は CommentService インターフェースの実装です
 type commentService struct {
-	commentRepo repositories.CommentRepository // コメントデータアクセス層
-	postRepo    repositories.PostRepository    // 投稿データアクセス層
-	validator   *validator.Validate            // バリデーター
+	commentRepo  repositories.CommentRepository  // コメントデータアクセス層
+	postRepo     repositories.PostRepository     // 投稿データアクセス層
+	reactionRepo repositories.ReactionRepository // リアクションデータアクセス層
+	validator    *validator.Validate             // バリデーター
 }
 
 // NewCommentService は新しい CommentService インスタンスを作成します
-func NewCommentService(commentRepo repositories.CommentRepository, postRepo repositories.PostRepository, validator *validator.Validate) CommentService {
+func NewCommentService(commentRepo repositories.CommentRepository, postRepo repositories.PostRepository, reactionRepo repositories.ReactionRepository, validator *validator.Validate) CommentService {
 	return &commentService{
-		commentRepo: commentRepo,
-		postRepo:    postRepo,
-		validator:   validator,
+		commentRepo:  commentRepo,
+		postRepo:     postRepo,
+		reactionRepo: reactionRepo,
+		validator:    validator,
 	}
 }
 
@@ -35,64 +55,139 @@ func NewCommentService(commentRepo repositories.CommentRepository, postRepo repo
 func (s *commentService) CreateComment(req *models.CommentCreateRequest) (*models.CommentResponse, error) {
 	// バリデーション
 	if err := s.validator.Struct(req); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+		return nil, validationError(err)
 	}
 
 	// 投稿が存在するかチェック
 	_, err := s.postRepo.GetByID(req.PostID)
 	if err != nil {
-		return nil, fmt.Errorf("post not found: %w", err)
+		return nil, lookupError("get post", err, ErrPostNotFound)
+	}
+
+	// 編集用トークンを生成
+	editToken, editTokenHash, err := generateToken()
+	if err != nil {
+		return nil, failedTo("generate edit token", err)
 	}
 
 	// リクエストをモデルに変換
 	comment := &models.Comment{
-		PostID:  req.PostID,
-		Content: req.Content,
+		PostID:        req.PostID,
+		Content:       req.Content,
+		EditTokenHash: editTokenHash,
+	}
+
+	// 返信の場合は返信先を確認し、スレッド情報を設定
+	if req.ParentID != nil {
+		parent, err := s.commentRepo.GetByID(*req.ParentID)
+		if err != nil {
+			return nil, lookupError("get parent comment", err, ErrParentCommentNotFound)
+		}
+		if parent.PostID != req.PostID {
+			return nil, ErrParentCommentMismatch
+		}
+		if parent.Depth >= maxCommentDepth {
+			return nil, ErrCommentDepthExceeded
+		}
+
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID = &parent.ID
+		comment.RootID = &rootID
+		comment.Depth = parent.Depth + 1
 	}
 
 	// データベースに保存
 	if err := s.commentRepo.Create(comment); err != nil {
-		return nil, fmt.Errorf("failed to create comment: %w", err)
+		return nil, failedTo("create comment", err)
 	}
 
 	// レスポンスに変換
-	response := &models.CommentResponse{
-		ID:        comment.ID,
-		PostID:    comment.PostID,
-		Content:   comment.Content,
-		CreatedAt: comment.CreatedAt,
-		UpdatedAt: comment.UpdatedAt,
-	}
+	response := newCommentResponse(comment)
+	response.EditToken = editToken
 
-	return response, nil
+	return &response, nil
 }
 
 // GetCommentsByPostID は指定された投稿のコメント一覧を取得します
-// 投稿の存在確認を行った後、コメントを取得します
-func (s *commentService) GetCommentsByPostID(postID uint) ([]models.CommentResponse, error) {
+// 投稿の存在確認を行った後、トップレベルのコメントを指定された並び順で limit 件ずつ、
+// 各スレッドの返信を含む返信ツリー形式で取得します
+func (s *commentService) GetCommentsByPostID(postID uint, sort string, limit int, cursor *pagination.Cursor) (*CommentListResult, error) {
 	// 投稿が存在するかチェック
 	_, err := s.postRepo.GetByID(postID)
 	if err != nil {
-		return nil, fmt.Errorf("post not found: %w", err)
+		return nil, lookupError("get post", err, ErrPostNotFound)
+	}
+
+	// 並び順・件数の既定値
+	if sort == "" {
+		sort = models.CommentSortNewest
+	}
+	if limit <= 0 || limit > 100 {
+		limit = defaultCommentLimit
+	}
+
+	return loadCommentPage(s.commentRepo, s.reactionRepo, postID, sort, limit, cursor)
+}
+
+// UpdateComment はコメントを更新します
+// 編集用トークンを検証した後、コメント内容を上書きします
+func (s *commentService) UpdateComment(id uint, editToken string, req *models.CommentUpdateRequest) (*models.CommentResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	// コメントを取得（非表示になったコメントも投稿者は編集できる）
+	comment, err := s.commentRepo.GetByIDIncludingHidden(id)
+	if err != nil {
+		return nil, lookupError("get comment", err, ErrCommentNotFound)
 	}
 
-	// コメントを取得
-	comments, err := s.commentRepo.GetByPostID(postID)
+	// 編集用トークンを検証
+	if !verifyEditToken(comment.EditTokenHash, editToken) {
+		return nil, ErrInvalidEditToken
+	}
+
+	// 内容を更新
+	comment.Content = req.Content
+
+	if err := s.commentRepo.Update(comment); err != nil {
+		return nil, failedTo("update comment", err)
+	}
+
+	// リアクション件数を取得
+	reactions, err := s.reactionRepo.CountByTarget(models.ReactionTargetComment, comment.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get comments: %w", err)
-	}
-
-	// レスポンス形式に変換
-	responses := make([]models.CommentResponse, len(comments))
-	for i, comment := range comments {
-		responses[i] = models.CommentResponse{
-			ID:        comment.ID,
-			PostID:    comment.PostID,
-			Content:   comment.Content,
-			CreatedAt: comment.CreatedAt,
-			UpdatedAt: comment.UpdatedAt,
-		}
+		return nil, failedTo("count reactions", err)
+	}
+
+	// レスポンスに変換
+	response := newCommentResponse(comment)
+	response.Reactions = reactions
+
+	return &response, nil
+}
+
+// DeleteComment はコメントを削除します
+// 編集用トークンを検証した後、論理削除を行います
+func (s *commentService) DeleteComment(id uint, editToken string) error {
+	// コメントを取得（非表示になったコメントも投稿者は削除できる）
+	comment, err := s.commentRepo.GetByIDIncludingHidden(id)
+	if err != nil {
+		return lookupError("get comment", err, ErrCommentNotFound)
+	}
+
+	// 編集用トークンを検証
+	if !verifyEditToken(comment.EditTokenHash, editToken) {
+		return ErrInvalidEditToken
+	}
+
+	if err := s.commentRepo.Delete(comment.ID); err != nil {
+		return failedTo("delete comment", err)
 	}
 
-	return responses, nil
+	return nil
 }
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/validators"
+	"gorm.io/gorm"
+)
+
+// fakeCommentRepository は非表示のコメントを1件だけ持つ CommentRepository です
+type fakeCommentRepository struct {
+	repositories.CommentRepository
+	comment *models.Comment
+	deleted bool
+}
+
+func (r *fakeCommentRepository) GetByID(id uint) (*models.Comment, error) {
+	if r.comment.ID != id || r.comment.HiddenAt != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.comment, nil
+}
+
+func (r *fakeCommentRepository) GetByIDIncludingHidden(id uint) (*models.Comment, error) {
+	if r.comment.ID != id || r.deleted {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.comment, nil
+}
+
+func (r *fakeCommentRepository) Update(comment *models.Comment) error {
+	r.comment = comment
+	return nil
+}
+
+func (r *fakeCommentRepository) Delete(id uint) error {
+	r.deleted = true
+	return nil
+}
+
+// fakeReactionRepository はリアクションが無い ReactionRepository です
+type fakeReactionRepository struct {
+	repositories.ReactionRepository
+}
+
+func (r *fakeReactionRepository) CountByTarget(targetType string, targetID uint) (models.ReactionCounts, error) {
+	return models.ReactionCounts{}, nil
+}
+
+// TestHiddenCommentEditableByAuthor は非表示になったコメントも編集用トークンで編集・削除できることを確認します
+func TestHiddenCommentEditableByAuthor(t *testing.T) {
+	validate, err := validators.NewValidate(validators.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, hash, err := generateToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hiddenAt := time.Now()
+	commentRepo := &fakeCommentRepository{comment: &models.Comment{ID: 1, Content: "before", EditTokenHash: hash, HiddenAt: &hiddenAt}}
+	service := NewCommentService(commentRepo, nil, &fakeReactionRepository{}, validate)
+
+	if _, err := service.UpdateComment(1, "wrong", &models.CommentUpdateRequest{Content: "after"}); !errors.Is(err, ErrInvalidEditToken) {
+		t.Fatalf("UpdateComment with a wrong token: error = %v, want ErrInvalidEditToken", err)
+	}
+	if _, err := service.UpdateComment(1, token, &models.CommentUpdateRequest{Content: "after"}); err != nil {
+		t.Fatal(err)
+	}
+	if commentRepo.comment.Content != "after" {
+		t.Errorf("content = %q, want %q", commentRepo.comment.Content, "after")
+	}
+	if err := service.DeleteComment(1, token); err != nil {
+		t.Fatal(err)
+	}
+	if !commentRepo.deleted {
+		t.Error("the hidden comment was not deleted")
+	}
+}
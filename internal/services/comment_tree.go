@@ -0,0 +1,160 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+)
+
+// maxCommentDepth はコメントの返信をネストできる最大の深さです
+// トップレベルのコメントが深さ 0 となります
+const maxCommentDepth = 3
+
+// defaultCommentLimit はコメント一覧で1回に返すスレッド数の既定値です
+const defaultCommentLimit = 20
+
+// commentPreviewLimit は投稿詳細に含めるスレッド数です
+const commentPreviewLimit = 3
+
+// loadCommentPage はトップレベルのコメントを並び順に従って limit 件取得し、
+// 各スレッドの返信とリアクション件数を含むツリー形式の一覧を作成します
+// 続きがある場合は次のページのカーソルを NextCursor に設定します
+func loadCommentPage(commentRepo repositories.CommentRepository, reactionRepo repositories.ReactionRepository, postID uint, sortOrder string, limit int, cursor *pagination.Cursor) (*CommentListResult, error) {
+	result := &CommentListResult{}
+
+	// 1件多く取得して続きの有無を判定する
+	roots, err := commentRepo.GetRootsByPostID(postID, sortOrder, cursor, limit+1)
+	if err != nil {
+		return nil, failedTo("get comments", err)
+	}
+	if len(roots) > limit {
+		roots = roots[:limit]
+		result.NextCursor = commentCursor(sortOrder, &roots[len(roots)-1]).Encode()
+	}
+
+	replies, err := commentRepo.GetRepliesByRootIDs(commentIDs(roots))
+	if err != nil {
+		return nil, failedTo("get comments", err)
+	}
+	comments := append(roots, replies...)
+
+	// リアクション件数を集計
+	reactions, err := reactionRepo.CountByTargets(models.ReactionTargetComment, commentIDs(comments))
+	if err != nil {
+		return nil, failedTo("count reactions", err)
+	}
+
+	// ツリー形式のレスポンスに変換
+	result.Comments = buildCommentTree(comments, reactions)
+	return result, nil
+}
+
+// commentCursor はコメントの直後から続きを取得するカーソルを返します
+func commentCursor(sortOrder string, comment *models.Comment) *pagination.Cursor {
+	key := pagination.TimeKey(comment.CreatedAt)
+	if sortOrder == models.CommentSortReactions {
+		key = comment.ReactionCount
+	}
+	return pagination.New(sortOrder, key, comment.ID)
+}
+
+// newCommentResponse はコメントモデルをレスポンス形式に変換します
+func newCommentResponse(comment *models.Comment) models.CommentResponse {
+	return models.CommentResponse{
+		ID:        comment.ID,
+		PostID:    comment.PostID,
+		ParentID:  comment.ParentID,
+		Depth:     comment.Depth,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		Replies:   []models.CommentResponse{},
+	}
+}
+
+// buildCommentTree はフラットなコメント一覧をツリー形式のレスポンスに変換します
+// トップレベルのコメントは渡された順序を保ち、返信は古い順に並べます
+// 返信先が削除されている場合はスレッドの起点に配置します（起点は削除・非表示でも本文を伏せて含まれます）
+// reactions にはコメントIDごとのリアクション件数を渡します
+func buildCommentTree(comments []models.Comment, reactions map[uint]models.ReactionCounts) []models.CommentResponse {
+	nodes := make(map[uint]*commentNode, len(comments))
+	for i := range comments {
+		nodes[comments[i].ID] = &commentNode{comment: &comments[i]}
+	}
+
+	var roots []*commentNode
+	for i := range comments {
+		node := nodes[comments[i].ID]
+		parent := findParentNode(nodes, &comments[i])
+		if parent == nil {
+			roots = append(roots, node)
+			continue
+		}
+		parent.children = append(parent.children, node)
+	}
+
+	responses := make([]models.CommentResponse, len(roots))
+	for i, root := range roots {
+		responses[i] = root.toResponse(reactions)
+	}
+	return responses
+}
+
+// commentNode はツリー構築用の中間ノードです
+type commentNode struct {
+	comment  *models.Comment
+	children []*commentNode
+}
+
+// toResponse はノードとその子孫をレスポンス形式に変換します
+func (n *commentNode) toResponse(reactions map[uint]models.ReactionCounts) models.CommentResponse {
+	response := newCommentResponse(n.comment)
+	if n.comment.DeletedAt.Valid || n.comment.HiddenAt != nil {
+		// 表示中の返信を残すため、削除・非表示の起点は本文を伏せて返す
+		response.Content = ""
+		response.Removed = true
+	} else {
+		response.Reactions = reactions[n.comment.ID]
+	}
+
+	sort.SliceStable(n.children, func(i, j int) bool {
+		a, b := n.children[i].comment, n.children[j].comment
+		if a.CreatedAt.Equal(b.CreatedAt) {
+			return a.ID < b.ID
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	response.ReplyCount = len(n.children)
+	for _, child := range n.children {
+		response.Replies = append(response.Replies, child.toResponse(reactions))
+	}
+	return response
+}
+
+// findParentNode はコメントの配置先となる親ノードを返します
+func findParentNode(nodes map[uint]*commentNode, comment *models.Comment) *commentNode {
+	if comment.ParentID == nil {
+		return nil
+	}
+	if parent, ok := nodes[*comment.ParentID]; ok {
+		return parent
+	}
+	if comment.RootID != nil {
+		if root, ok := nodes[*comment.RootID]; ok {
+			return root
+		}
+	}
+	return nil
+}
+
+// commentIDs はコメント一覧のIDを返します
+func commentIDs(comments []models.Comment) []uint {
+	ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+	return ids
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/search"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrCompanyNameConflict は企業名・別名が他の企業と重複する場合のエラーです
+	ErrCompanyNameConflict = errors.New("company name or alias is already used by another company")
+	// ErrCompanyMergeSelf は同じ企業同士を統合しようとした場合のエラーです
+	ErrCompanyMergeSelf = errors.New("cannot merge a company into itself")
+)
+
+// CompanyService は企業に関するビジネスロジックを定義するインターフェースです
+type CompanyService interface {
+	GetCompanies(page, limit int, keyword string) (*CompanyListResult, error)
+	GetCompany(id uint) (*models.CompanyResponse, error)
+	UpdateCompany(id uint, req *models.CompanyUpdateRequest) (*models.CompanyResponse, error)
+	MergeCompanies(targetID uint, req *models.CompanyMergeRequest) (*models.CompanyResponse, error)
+	SuggestCompanies(q string, limit int) []models.CompanySuggestion
+	RefreshSuggestIndex() error
+}
+
+// CompanyListResult は企業一覧取得の結果を表す構造体です
+type CompanyListResult struct {
+	Companies  []models.CompanyResponse `json:"companies"`   // 企業一覧
+	Total      int64                    `json:"total"`       // 総件数
+	Page       int                      `json:"page"`        // 現在のページ
+	Limit      int                      `json:"limit"`       // 1ページあたりの件数
+	TotalPages int                      `json:"total_pages"` // 総ページ数
+}
+
+// companyService は CompanyService インターフェースの実装です
+type companyService struct {
+	companyRepo  repositories.CompanyRepository // 企業データアクセス層
+	suggestIndex *search.SuggestIndex           // 企業名補完用のインデックス
+	validator    *validator.Validate            // バリデーター
+}
+
+// NewCompanyService は新しい CompanyService インスタンスを作成します
+// suggestIndex は投稿作成時に PostService からも更新されるため共有して渡します
+func NewCompanyService(companyRepo repositories.CompanyRepository, suggestIndex *search.SuggestIndex, validator *validator.Validate) CompanyService {
+	return &companyService{
+		companyRepo:  companyRepo,
+		suggestIndex: suggestIndex,
+		validator:    validator,
+	}
+}
+
+// GetCompanies は企業一覧を投稿数の多い順に取得します
+// keyword を指定すると企業名・読み・別名の部分一致で絞り込みます
+func (s *companyService) GetCompanies(page, limit int, keyword string) (*CompanyListResult, error) {
+	// ページネーション設定のバリデーション
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	offset := (page - 1) * limit
+
+	companies, total, err := s.companyRepo.GetAll(limit, offset, keyword)
+	if err != nil {
+		return nil, failedTo("get companies", err)
+	}
+
+	responses := make([]models.CompanyResponse, len(companies))
+	for i := range companies {
+		responses[i] = newCompanyResponse(&companies[i])
+	}
+
+	// 総ページ数を計算
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &CompanyListResult{
+		Companies:  responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetCompany は指定されたIDの企業を取得します
+func (s *companyService) GetCompany(id uint) (*models.CompanyResponse, error) {
+	company, err := s.companyRepo.GetByID(id)
+	if err != nil {
+		return nil, lookupError("get company", err, ErrCompanyNotFound)
+	}
+
+	response := newCompanyResponse(company)
+	return &response, nil
+}
+
+// UpdateCompany は企業の正式名・読み・別名を更新します
+// 企業名や別名が他の企業と重複する場合は更新できません（統合を使用してください）
+func (s *companyService) UpdateCompany(id uint, req *models.CompanyUpdateRequest) (*models.CompanyResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	if _, _, err := updateCompany(s.companyRepo, id, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.RefreshSuggestIndex(); err != nil {
+		return nil, err
+	}
+
+	return s.GetCompany(id)
+}
+
+// MergeCompanies は表記ゆれで別々に登録された企業を1つに統合します
+func (s *companyService) MergeCompanies(targetID uint, req *models.CompanyMergeRequest) (*models.CompanyResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	if err := mergeCompanies(s.companyRepo, targetID, req.SourceID); err != nil {
+		return nil, err
+	}
+
+	if err := s.RefreshSuggestIndex(); err != nil {
+		return nil, err
+	}
+
+	return s.GetCompany(targetID)
+}
+
+// updateCompany は検証済みのリクエストで企業を更新し、更新後の企業と別名を返します
+// モデレーターの操作として監査ログと同じトランザクションで実行できるよう、リポジトリを受け取ります
+func updateCompany(companyRepo repositories.CompanyRepository, id uint, req *models.CompanyUpdateRequest) (*models.Company, []string, error) {
+	company, err := companyRepo.GetByID(id)
+	if err != nil {
+		return nil, nil, lookupError("get company", err, ErrCompanyNotFound)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	nameKey := search.CompanyNameKey(name)
+	if err := checkKeyAvailable(companyRepo, nameKey, company.ID); err != nil {
+		return nil, nil, err
+	}
+
+	// 別名を正規化キー単位で重複除去
+	seen := map[string]bool{nameKey: true}
+	var aliases []string
+	for _, alias := range req.Aliases {
+		alias = strings.TrimSpace(alias)
+		key := search.CompanyNameKey(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+		if err := checkKeyAvailable(companyRepo, key, company.ID); err != nil {
+			return nil, nil, err
+		}
+		seen[key] = true
+		aliases = append(aliases, alias)
+	}
+
+	// 改名前の企業名で投稿・検索している利用者のため、旧名を別名として残す
+	if oldKey := company.NormalizedName; oldKey != "" && !seen[oldKey] {
+		aliases = append(aliases, company.Name)
+	}
+
+	company.Name = name
+	company.NormalizedName = nameKey
+	company.Kana = search.ToHiragana(search.Normalize(strings.TrimSpace(req.Kana)))
+
+	if err := companyRepo.Update(company, aliases); err != nil {
+		return nil, nil, failedTo("update company", err)
+	}
+	return company, aliases, nil
+}
+
+// mergeCompanies は source の企業を target の企業に統合します
+// モデレーターの操作として監査ログと同じトランザクションで実行できるよう、リポジトリを受け取ります
+func mergeCompanies(companyRepo repositories.CompanyRepository, targetID, sourceID uint) error {
+	if sourceID == targetID {
+		return ErrCompanyMergeSelf
+	}
+	if err := companyRepo.Merge(targetID, sourceID); err != nil {
+		return lookupError("merge companies", err, ErrCompanyNotFound)
+	}
+	return nil
+}
+
+// SuggestCompanies は入力途中の企業名に前方一致する企業を返します
+// 企業名・読み・別名の順に優先し、同じ優先度では投稿数の多い順に並べます
+func (s *companyService) SuggestCompanies(q string, limit int) []models.CompanySuggestion {
+	suggestions := s.suggestIndex.Suggest(q, limit)
+
+	responses := make([]models.CompanySuggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		responses[i] = models.CompanySuggestion{
+			ID:           suggestion.ID,
+			Name:         suggestion.Name,
+			Kana:         suggestion.Kana,
+			MatchedAlias: suggestion.Matched,
+			PostCount:    suggestion.PostCount,
+		}
+	}
+	return responses
+}
+
+// RefreshSuggestIndex はデータベースの企業情報から補完用インデックスを作り直します
+func (s *companyService) RefreshSuggestIndex() error {
+	companies, err := s.companyRepo.ListAll()
+	if err != nil {
+		return failedTo("load companies", err)
+	}
+
+	entries := make([]search.SuggestEntry, len(companies))
+	for i, company := range companies {
+		aliases := make([]string, len(company.Aliases))
+		for j, alias := range company.Aliases {
+			aliases[j] = alias.Alias
+		}
+		entries[i] = search.SuggestEntry{
+			ID:        company.ID,
+			Name:      company.Name,
+			Kana:      company.Kana,
+			Aliases:   aliases,
+			PostCount: company.PostCount,
+		}
+	}
+
+	s.suggestIndex.Replace(entries)
+	return nil
+}
+
+// checkKeyAvailable は正規化済みの企業名・別名が他の企業で使われていないか確認します
+func checkKeyAvailable(companyRepo repositories.CompanyRepository, key string, companyID uint) error {
+	existing, err := companyRepo.FindByKey(key)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return failedTo("check company name", err)
+	}
+	if existing.ID != companyID {
+		return ErrCompanyNameConflict
+	}
+	return nil
+}
+
+// newCompanyResponse は企業モデルをレスポンス形式に変換します
+func newCompanyResponse(company *models.Company) models.CompanyResponse {
+	aliases := make([]string, len(company.Aliases))
+	for i, alias := range company.Aliases {
+		aliases[i] = alias.Alias
+	}
+	return models.CompanyResponse{
+		ID:        company.ID,
+		Name:      company.Name,
+		Kana:      company.Kana,
+		Aliases:   aliases,
+		PostCount: company.PostCount,
+		CreatedAt: company.CreatedAt,
+	}
+}
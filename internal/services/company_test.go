@@ -0,0 +1,69 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// fakeCompanyRepository は企業の更新に必要な操作だけをメモリ上で行う CompanyRepository です
+type fakeCompanyRepository struct {
+	repositories.CompanyRepository
+	company *models.Company
+	aliases []string
+}
+
+func (r *fakeCompanyRepository) GetByID(id uint) (*models.Company, error) {
+	if r.company == nil || r.company.ID != id {
+		return nil, gorm.ErrRecordNotFound
+	}
+	company := *r.company
+	return &company, nil
+}
+
+func (r *fakeCompanyRepository) FindByKey(key string) (*models.Company, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeCompanyRepository) Update(company *models.Company, aliases []string) error {
+	r.company = company
+	r.aliases = aliases
+	return nil
+}
+
+// TestUpdateCompanyKeepsOldName は改名した場合に旧名を別名として残すことを確認します
+func TestUpdateCompanyKeepsOldName(t *testing.T) {
+	repo := &fakeCompanyRepository{company: &models.Company{ID: 1, Name: "旧サンプル", NormalizedName: "旧さんぷる"}}
+
+	req := &models.CompanyUpdateRequest{Name: "新サンプル", Aliases: []string{"サンプル"}}
+	if _, _, err := updateCompany(repo, 1, req); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"サンプル", "旧サンプル"}; !slices.Equal(repo.aliases, want) {
+		t.Errorf("aliases = %v, want %v", repo.aliases, want)
+	}
+
+	// 表記ゆれの修正だけなら旧名は別名にしない
+	req = &models.CompanyUpdateRequest{Name: "新サンプル株式会社"}
+	if _, _, err := updateCompany(repo, 1, req); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.aliases) != 0 {
+		t.Errorf("aliases = %v, want none", repo.aliases)
+	}
+}
+
+// TestUpdateCompanyEntityOnlyName は法人格だけの企業名でも比較用のキーが空にならないことを確認します
+func TestUpdateCompanyEntityOnlyName(t *testing.T) {
+	repo := &fakeCompanyRepository{company: &models.Company{ID: 1, Name: "サンプル", NormalizedName: "さんぷる"}}
+
+	if _, _, err := updateCompany(repo, 1, &models.CompanyUpdateRequest{Name: "株式会社"}); err != nil {
+		t.Fatal(err)
+	}
+	if repo.company.NormalizedName != "株式会社" {
+		t.Errorf("NormalizedName = %q, want %q", repo.company.NormalizedName, "株式会社")
+	}
+}
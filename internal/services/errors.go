@@ -0,0 +1,48 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrValidation は入力値の検証に失敗した場合のエラーです
+	// validator.ValidationErrors をあわせて返すため、項目ごとの内容は errors.As で取り出せます
+	ErrValidation = errors.New("validation failed")
+	// ErrPostNotFound は投稿が存在しない（削除・非表示を含む）場合のエラーです
+	ErrPostNotFound = errors.New("post not found")
+	// ErrCommentNotFound はコメントが存在しない場合のエラーです
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrCompanyNotFound は企業が存在しない場合のエラーです
+	ErrCompanyNotFound = errors.New("company not found")
+	// ErrReportNotFound は通報が存在しない場合のエラーです
+	ErrReportNotFound = errors.New("report not found")
+	// ErrUnavailable はデータベースに接続できないなど、一時的な障害で処理できない場合のエラーです
+	ErrUnavailable = errors.New("service temporarily unavailable")
+)
+
+// validationError は検証エラーを ErrValidation として返します
+func validationError(err error) error {
+	return fmt.Errorf("%w: %w", ErrValidation, err)
+}
+
+// lookupError は対象を指定した action に失敗したことを表すエラーを返します
+// レコードが存在しない場合は notFound を返し、データベースの障害などと区別できるようにします
+func lookupError(action string, err, notFound error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return failedTo(action, err)
+}
+
+// failedTo は action に失敗したことを表すエラーを返します
+// データベースに接続できないなど一時的な障害によるエラーは ErrUnavailable として判別できるようにします
+func failedTo(action string, err error) error {
+	if repositories.IsUnavailable(err) {
+		return fmt.Errorf("failed to %s: %w: %w", action, ErrUnavailable, err)
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+)
+
+// exportBatchSize はエクスポート時に一度に読み込む投稿数です
+const exportBatchSize = 200
+
+// ExportService はデータのエクスポートを定義するインターフェースです
+type ExportService interface {
+	ExportPosts(w io.Writer) (int, error)
+}
+
+// exportService は ExportService インターフェースの実装です
+type exportService struct {
+	postRepo repositories.PostRepository // 投稿データアクセス層
+}
+
+// NewExportService は新しい ExportService インスタンスを作成します
+func NewExportService(postRepo repositories.PostRepository) ExportService {
+	return &exportService{
+		postRepo: postRepo,
+	}
+}
+
+// ExportPosts は削除されていないすべての投稿をコメントとあわせて JSON Lines 形式（1行1投稿）で書き出し、書き出した件数を返します
+// 非表示の投稿・コメントも hidden を付けて含めます
+func (s *exportService) ExportPosts(w io.Writer) (int, error) {
+	encoder := json.NewEncoder(w)
+	count := 0
+	var afterID uint
+	for {
+		posts, err := s.postRepo.GetForExport(afterID, exportBatchSize)
+		if err != nil {
+			return count, failedTo("get posts", err)
+		}
+		for i := range posts {
+			if err := encoder.Encode(newPostExport(&posts[i])); err != nil {
+				return count, failedTo("write post", err)
+			}
+			count++
+		}
+		if len(posts) < exportBatchSize {
+			return count, nil
+		}
+		afterID = posts[len(posts)-1].ID
+	}
+}
+
+// newPostExport は投稿をエクスポート用の構造体に変換します
+func newPostExport(post *models.Post) models.PostExport {
+	comments := make([]models.CommentExport, len(post.Comments))
+	for i, comment := range post.Comments {
+		comments[i] = models.CommentExport{
+			ID:            comment.ID,
+			ParentID:      comment.ParentID,
+			Content:       comment.Content,
+			ReactionCount: comment.ReactionCount,
+			Hidden:        comment.HiddenAt != nil,
+			CreatedAt:     comment.CreatedAt,
+			UpdatedAt:     comment.UpdatedAt,
+		}
+	}
+
+	return models.PostExport{
+		ID:            post.ID,
+		Title:         post.Title,
+		Content:       post.Content,
+		Category:      post.Category,
+		CompanyName:   post.CompanyName,
+		CompanyID:     post.CompanyID,
+		JobType:       post.JobType,
+		Interview:     newInterviewResponse(post.Interview),
+		Tags:          tagNames(post.Tags),
+		ReactionCount: post.ReactionCount,
+		Hidden:        post.HiddenAt != nil,
+		CreatedAt:     post.CreatedAt,
+		UpdatedAt:     post.UpdatedAt,
+		Comments:      comments,
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// ErrInterviewNotAllowed は面接以外のカテゴリの投稿に面接情報が指定された場合のエラーです
+var ErrInterviewNotAllowed = errors.New("interview details are only allowed for interview category posts")
+
+// interviewDateLayout は面接日の日付形式です
+const interviewDateLayout = "2006-01-02"
+
+// newPostInterview はリクエストの面接情報をカテゴリに応じて検証し、モデルに変換します
+// 面接情報を登録できるのは is_interview のカテゴリの投稿のみです
+// 面接情報が未指定、またはすべての項目が空の場合は nil を返します
+func newPostInterview(categoryRepo repositories.CategoryRepository, categoryName string, req *models.PostInterviewRequest) (*models.PostInterview, error) {
+	if req == nil || req.IsEmpty() {
+		return nil, nil
+	}
+	category, err := categoryRepo.GetByName(categoryName)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, failedTo("get category", err)
+	}
+	if category == nil || !category.IsInterview {
+		return nil, validationError(ErrInterviewNotAllowed)
+	}
+
+	interview := &models.PostInterview{
+		Stage:            req.Stage,
+		Format:           req.Format,
+		DurationMinutes:  req.DurationMinutes,
+		InterviewerCount: req.InterviewerCount,
+		Outcome:          req.Outcome,
+	}
+	if req.InterviewedOn != "" {
+		// 形式はバリデーションで確認済み
+		interviewedOn, err := time.Parse(interviewDateLayout, req.InterviewedOn)
+		if err != nil {
+			return nil, validationError(err)
+		}
+		interview.InterviewedOn = &interviewedOn
+	}
+	return interview, nil
+}
+
+// newInterviewResponse は面接情報をレスポンス形式に変換します
+func newInterviewResponse(interview *models.PostInterview) *models.PostInterviewResponse {
+	if interview == nil {
+		return nil
+	}
+
+	response := &models.PostInterviewResponse{
+		Stage:            interview.Stage,
+		Format:           interview.Format,
+		DurationMinutes:  interview.DurationMinutes,
+		InterviewerCount: interview.InterviewerCount,
+		Outcome:          interview.Outcome,
+	}
+	if interview.InterviewedOn != nil {
+		response.InterviewedOn = interview.InterviewedOn.Format(interviewDateLayout)
+	}
+	return response
+}
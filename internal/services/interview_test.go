@@ -0,0 +1,60 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// fakeCategoryRepository はメモリ上のカテゴリを名前で検索する CategoryRepository です
+type fakeCategoryRepository struct {
+	repositories.CategoryRepository
+	categories []models.Category
+}
+
+func (r *fakeCategoryRepository) GetByName(name string) (*models.Category, error) {
+	for i := range r.categories {
+		if r.categories[i].Name == name {
+			return &r.categories[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// TestNewPostInterviewCategory は面接情報をカテゴリ名ではなく is_interview で判定することを確認します
+func TestNewPostInterviewCategory(t *testing.T) {
+	repo := &fakeCategoryRepository{categories: []models.Category{
+		{Name: "面接", IsInterview: false},
+		{Name: "選考体験記", IsInterview: true},
+	}}
+	req := &models.PostInterviewRequest{Stage: models.InterviewStageFirst}
+
+	tests := []struct {
+		category string
+		allowed  bool
+	}{
+		{"選考体験記", true},
+		{"面接", false},
+		{"存在しないカテゴリ", false},
+	}
+	for _, tt := range tests {
+		interview, err := newPostInterview(repo, tt.category, req)
+		if tt.allowed {
+			if err != nil || interview == nil {
+				t.Errorf("%s: interview = %v, error = %v, want an interview", tt.category, interview, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrInterviewNotAllowed) || !errors.Is(err, ErrValidation) {
+			t.Errorf("%s: error = %v, want ErrInterviewNotAllowed", tt.category, err)
+		}
+	}
+
+	// 面接情報が空の場合はカテゴリを確認しない
+	if interview, err := newPostInterview(nil, "面接", &models.PostInterviewRequest{}); interview != nil || err != nil {
+		t.Errorf("empty request: interview = %v, error = %v, want nil", interview, err)
+	}
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// ModerationService はモデレーターによる管理操作を定義するインターフェースです
+// すべての操作は監査ログに記録されます
+type ModerationService interface {
+	SetPostHidden(moderatorID, postID uint, hidden bool, req *models.ModerationActionRequest) error
+	DeletePost(moderatorID, postID uint, req *models.ModerationActionRequest) error
+	SetCommentHidden(moderatorID, commentID uint, hidden bool, req *models.ModerationActionRequest) error
+	DeleteComment(moderatorID, commentID uint, req *models.ModerationActionRequest) error
+	ResolveReport(moderatorID, reportID uint, req *models.ReportResolveRequest) (*models.ReportResponse, error)
+	UpdateCompany(moderatorID, companyID uint, req *models.CompanyUpdateRequest) (*models.CompanyResponse, error)
+	MergeCompanies(moderatorID, targetID uint, req *models.CompanyMergeRequest) (*models.CompanyResponse, error)
+	GetAuditLogs(page, limit int, moderatorID uint, action string) (*AuditLogListResult, error)
+}
+
+// AuditLogListResult は監査ログ一覧取得の結果を表す構造体です
+type AuditLogListResult struct {
+	Logs       []models.AuditLogResponse `json:"logs"`        // 監査ログ一覧
+	Total      int64                     `json:"total"`       // 総件数
+	Page       int                       `json:"page"`        // 現在のページ
+	Limit      int                       `json:"limit"`       // 1ページあたりの件数
+	TotalPages int                       `json:"total_pages"` // 総ページ数
+}
+
+// moderationService は ModerationService インターフェースの実装です
+type moderationService struct {
+	transactor     repositories.Transactor         // 操作と監査ログを同じトランザクションで記録する
+	auditLogRepo   repositories.AuditLogRepository // 監査ログデータアクセス層
+	companyService CompanyService                  // 企業のビジネスロジック
+	validator      *validator.Validate             // バリデーター
+}
+
+// NewModerationService は新しい ModerationService インスタンスを作成します
+func NewModerationService(transactor repositories.Transactor, auditLogRepo repositories.AuditLogRepository, companyService CompanyService, validator *validator.Validate) ModerationService {
+	return &moderationService{
+		transactor:     transactor,
+		auditLogRepo:   auditLogRepo,
+		companyService: companyService,
+		validator:      validator,
+	}
+}
+
+// SetPostHidden は投稿を非表示にする、または表示に戻します
+func (s *moderationService) SetPostHidden(moderatorID, postID uint, hidden bool, req *models.ModerationActionRequest) error {
+	if err := s.validator.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	action := models.AuditActionUnhidePost
+	if hidden {
+		action = models.AuditActionHidePost
+	}
+	err := s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		if err := repos.Posts.SetHidden(postID, hidden); err != nil {
+			return lookupError("update post", err, ErrPostNotFound)
+		}
+		return writeAuditLog(repos.AuditLogs, moderatorID, action, models.ReportTargetPost, postID, req.Reason)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 企業ごとの投稿数が変わるため企業名補完のインデックスを作り直す
+	return s.companyService.RefreshSuggestIndex()
+}
+
+// DeletePost は投稿とその関連データを物理削除します
+func (s *moderationService) DeletePost(moderatorID, postID uint, req *models.ModerationActionRequest) error {
+	if err := s.validator.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	err := s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		if err := repos.Posts.HardDelete(postID); err != nil {
+			return lookupError("delete post", err, ErrPostNotFound)
+		}
+		return writeAuditLog(repos.AuditLogs, moderatorID, models.AuditActionDeletePost, models.ReportTargetPost, postID, req.Reason)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 企業ごとの投稿数が変わるため企業名補完のインデックスを作り直す
+	return s.companyService.RefreshSuggestIndex()
+}
+
+// SetCommentHidden はコメントを非表示にする、または表示に戻します
+func (s *moderationService) SetCommentHidden(moderatorID, commentID uint, hidden bool, req *models.ModerationActionRequest) error {
+	if err := s.validator.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	action := models.AuditActionUnhideComment
+	if hidden {
+		action = models.AuditActionHideComment
+	}
+	return s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		if err := repos.Comments.SetHidden(commentID, hidden); err != nil {
+			return lookupError("update comment", err, ErrCommentNotFound)
+		}
+		return writeAuditLog(repos.AuditLogs, moderatorID, action, models.ReportTargetComment, commentID, req.Reason)
+	})
+}
+
+// DeleteComment はコメントとその関連データを物理削除します
+func (s *moderationService) DeleteComment(moderatorID, commentID uint, req *models.ModerationActionRequest) error {
+	if err := s.validator.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	return s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		if err := repos.Comments.HardDelete(commentID); err != nil {
+			return lookupError("delete comment", err, ErrCommentNotFound)
+		}
+		return writeAuditLog(repos.AuditLogs, moderatorID, models.AuditActionDeleteComment, models.ReportTargetComment, commentID, req.Reason)
+	})
+}
+
+// ResolveReport は通報に対応し、その内容を監査ログに記録します
+// 同じ対象への未対応の通報はまとめて処理され、
+// hide の場合は対象を非表示のまま確定し、dismiss の場合は対象を表示に戻します
+func (s *moderationService) ResolveReport(moderatorID, reportID uint, req *models.ReportResolveRequest) (*models.ReportResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	status := models.ReportStatusResolved
+	hidden := true
+	if req.Action == models.ReportActionDismiss {
+		status = models.ReportStatusDismissed
+		hidden = false
+	}
+
+	detail := req.Action
+	if req.Note != "" {
+		detail += ": " + req.Note
+	}
+
+	var report *models.Report
+	err := s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		found, err := repos.Reports.GetByID(reportID)
+		if err != nil {
+			return lookupError("get report", err, ErrReportNotFound)
+		}
+		if found.Status != models.ReportStatusOpen {
+			return ErrReportAlreadyResolved
+		}
+
+		// 対象が投稿者によって削除済みの場合は、非表示の切り替えを省いて通報のみ対応済みにする
+		err = setReportTargetHidden(repos.Posts, repos.Comments, found.TargetType, found.TargetID, hidden)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return failedTo("update reported content", err)
+		}
+		if err := repos.Reports.ResolveByTarget(found.TargetType, found.TargetID, status, req.Note); err != nil {
+			return failedTo("resolve report", err)
+		}
+		if err := writeAuditLog(repos.AuditLogs, moderatorID, models.AuditActionResolveReport, "report", reportID, detail); err != nil {
+			return err
+		}
+
+		// 更新後の状態を取得
+		report, err = repos.Reports.GetByID(reportID)
+		if err != nil {
+			return failedTo("get report", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 投稿の表示状態が変わった場合は企業名補完のインデックスを作り直す
+	if report.TargetType == models.ReportTargetPost {
+		if err := s.companyService.RefreshSuggestIndex(); err != nil {
+			return nil, err
+		}
+	}
+
+	response := newReportResponse(report)
+	return &response, nil
+}
+
+// UpdateCompany は企業の正式名・読み・別名を更新し、その内容を監査ログに記録します
+// 企業の更新と監査ログの記録は同じトランザクションで行います
+func (s *moderationService) UpdateCompany(moderatorID, companyID uint, req *models.CompanyUpdateRequest) (*models.CompanyResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	err := s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		company, aliases, err := updateCompany(repos.Companies, companyID, req)
+		if err != nil {
+			return err
+		}
+
+		detail := fmt.Sprintf("name=%s kana=%s aliases=%v", company.Name, company.Kana, aliases)
+		return writeAuditLog(repos.AuditLogs, moderatorID, models.AuditActionUpdateCompany, "company", companyID, detail)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.companyService.RefreshSuggestIndex(); err != nil {
+		return nil, err
+	}
+
+	return s.companyService.GetCompany(companyID)
+}
+
+// MergeCompanies は企業を統合し、その内容を監査ログに記録します
+// 企業の統合と監査ログの記録は同じトランザクションで行います
+func (s *moderationService) MergeCompanies(moderatorID, targetID uint, req *models.CompanyMergeRequest) (*models.CompanyResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	err := s.transactor.Transaction(func(repos repositories.TxRepositories) error {
+		if err := mergeCompanies(repos.Companies, targetID, req.SourceID); err != nil {
+			return err
+		}
+
+		detail := fmt.Sprintf("merged company %d", req.SourceID)
+		return writeAuditLog(repos.AuditLogs, moderatorID, models.AuditActionMergeCompany, "company", targetID, detail)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.companyService.RefreshSuggestIndex(); err != nil {
+		return nil, err
+	}
+
+	return s.companyService.GetCompany(targetID)
+}
+
+// GetAuditLogs は監査ログの一覧を新しい順に取得します
+func (s *moderationService) GetAuditLogs(page, limit int, moderatorID uint, action string) (*AuditLogListResult, error) {
+	// ページネーション設定のバリデーション
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	offset := (page - 1) * limit
+
+	logs, total, err := s.auditLogRepo.GetAll(limit, offset, moderatorID, action)
+	if err != nil {
+		return nil, failedTo("get audit logs", err)
+	}
+
+	responses := make([]models.AuditLogResponse, len(logs))
+	for i, log := range logs {
+		responses[i] = models.AuditLogResponse{
+			ID:                log.ID,
+			ModeratorID:       log.ModeratorID,
+			ModeratorUsername: log.Moderator.Username,
+			Action:            log.Action,
+			TargetType:        log.TargetType,
+			TargetID:          log.TargetID,
+			Detail:            log.Detail,
+			CreatedAt:         log.CreatedAt,
+		}
+	}
+
+	// 総ページ数を計算
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &AuditLogListResult{
+		Logs:       responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// writeAuditLog は監査ログを記録します
+// 操作と同じトランザクションで記録する場合は、トランザクション内のリポジトリを渡します
+func writeAuditLog(auditLogRepo repositories.AuditLogRepository, moderatorID uint, action, targetType string, targetID uint, detail string) error {
+	err := auditLogRepo.Create(&models.AuditLog{
+		ModeratorID: moderatorID,
+		Action:      action,
+		TargetType:  targetType,
+		TargetID:    targetID,
+		Detail:      detail,
+	})
+	if err != nil {
+		return failedTo("write audit log", err)
+	}
+	return nil
+}
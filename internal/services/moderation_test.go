@@ -0,0 +1,145 @@
+package services
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/search"
+	"github.com/latttchc/finding-forest-backend/internal/validators"
+	"gorm.io/gorm"
+)
+
+// newTestModerationService は sqlmock のデータベースを使用する ModerationService を作成します
+func newTestModerationService(t *testing.T, db *gorm.DB) ModerationService {
+	t.Helper()
+	validate, err := validators.NewValidate(validators.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	companyService := NewCompanyService(repositories.NewCompanyRepository(db), search.NewSuggestIndex(), validate)
+	return NewModerationService(repositories.NewTransactor(db), repositories.NewAuditLogRepository(db), companyService, validate)
+}
+
+// TestSetPostHiddenAuditLog は非表示の操作と監査ログを同じトランザクションで記録し、
+// コミット後に企業名補完のインデックスを作り直すことを確認します
+func TestSetPostHiddenAuditLog(t *testing.T) {
+	c := newQueryCounter(t)
+	service := newTestModerationService(t, c.db)
+
+	c.mock.ExpectBegin()
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET "hidden_at"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_logs"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	c.mock.ExpectCommit()
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT companies.*, COUNT(posts.id) AS post_count FROM "companies"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "post_count"}))
+
+	if err := service.SetPostHidden(1, 10, true, &models.ModerationActionRequest{Reason: "spam"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestSetPostHiddenAuditLogFailure は監査ログを記録できない場合に非表示の操作を取り消すことを確認します
+func TestSetPostHiddenAuditLogFailure(t *testing.T) {
+	c := newQueryCounter(t)
+	service := newTestModerationService(t, c.db)
+
+	c.mock.ExpectBegin()
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET "hidden_at"`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_logs"`)).WillReturnError(errors.New("insert failed"))
+	c.mock.ExpectRollback()
+
+	if err := service.SetPostHidden(1, 10, true, &models.ModerationActionRequest{}); err == nil {
+		t.Fatal("SetPostHidden succeeded, want an error")
+	}
+	if err := c.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestSetPostHiddenNotFound は対象の投稿が無い場合に監査ログを記録しないことを確認します
+func TestSetPostHiddenNotFound(t *testing.T) {
+	c := newQueryCounter(t)
+	service := newTestModerationService(t, c.db)
+
+	c.mock.ExpectBegin()
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET "hidden_at"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	c.mock.ExpectRollback()
+
+	if err := service.SetPostHidden(1, 10, true, &models.ModerationActionRequest{}); !errors.Is(err, ErrPostNotFound) {
+		t.Fatalf("SetPostHidden error = %v, want ErrPostNotFound", err)
+	}
+	if err := c.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestResolveReportDeletedTarget は投稿者が削除済みの投稿への通報も対応済みにできることを確認します
+func TestResolveReportDeletedTarget(t *testing.T) {
+	c := newQueryCounter(t)
+	service := newTestModerationService(t, c.db)
+
+	report := func(status string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "target_type", "target_id", "reason", "status"}).
+			AddRow(5, models.ReportTargetPost, 10, "spam", status)
+	}
+
+	c.mock.ExpectBegin()
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reports"`)).WillReturnRows(report(models.ReportStatusOpen))
+	// 論理削除済みの投稿は更新されない
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET "hidden_at"`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "reports" SET`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_logs"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reports"`)).WillReturnRows(report(models.ReportStatusResolved))
+	c.mock.ExpectCommit()
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT companies.*, COUNT(posts.id) AS post_count FROM "companies"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "post_count"}))
+
+	response, err := service.ResolveReport(1, 5, &models.ReportResolveRequest{Action: models.ReportActionHide})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Status != models.ReportStatusResolved {
+		t.Errorf("status = %q, want %q", response.Status, models.ReportStatusResolved)
+	}
+	if err := c.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestMergeCompaniesAuditLogFailure は監査ログを記録できない場合に企業の統合を取り消すことを確認します
+func TestMergeCompaniesAuditLogFailure(t *testing.T) {
+	c := newQueryCounter(t)
+	service := newTestModerationService(t, c.db)
+
+	company := func(id int, name string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name", "normalized_name"}).AddRow(id, name, name)
+	}
+
+	c.mock.ExpectBegin()
+	c.mock.ExpectExec(regexp.QuoteMeta(`SAVEPOINT`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "companies"`)).WillReturnRows(company(1, "target"))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "companies"`)).WillReturnRows(company(2, "source"))
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "posts" SET`)).WillReturnResult(sqlmock.NewResult(0, 3))
+	c.mock.ExpectExec(regexp.QuoteMeta(`UPDATE "company_aliases" SET`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	c.mock.ExpectExec(`(DELETE FROM|UPDATE) "companies"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "company_aliases"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_logs"`)).WillReturnError(errors.New("insert failed"))
+	c.mock.ExpectRollback()
+
+	if _, err := service.MergeCompanies(1, 1, &models.CompanyMergeRequest{SourceID: 2}); err == nil {
+		t.Fatal("MergeCompanies succeeded, want an error")
+	}
+	if err := c.mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,42 +1,57 @@
 package services
 
 import (
-	"fmt"
-
 	"github.com/go-playground/validator/v10"
 	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/pagination"
 	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/search"
 )
 
 // PostService は投稿に関するビジネスロジックを定義するインターフェースです
 type PostService interface {
 	CreatePost(req *models.PostCreateRequest) (*models.PostResponse, error)
 	GetPost(id uint) (*models.PostDetailResponse, error)
-	GetPosts(page, limit int, category, companyName string) (*PostListResult, error)
+	GetPosts(filter models.PostFilter, page, limit int, cursor *pagination.Cursor) (*PostListResult, error)
+	UpdatePost(id uint, editToken string, req *models.PostUpdateRequest) (*models.PostResponse, error)
+	DeletePost(id uint, editToken string) error
+	GetPostsByCompany(companyID uint, page, limit int, cursor *pagination.Cursor) (*PostListResult, error)
 }
 
 // PostListResult は投稿一覧取得の結果を表す構造体です
+// ページ番号指定時は総件数・ページ情報を、カーソル指定時は次のカーソルのみを返します
 type PostListResult struct {
-	Posts      []models.PostListResponse `json:"posts"`       // 投稿一覧
-	Total      int64                     `json:"total"`       // 総件数
-	Page       int                       `json:"page"`        // 現在のページ
-	Limit      int                       `json:"limit"`       // 1ページあたりの件数
-	TotalPages int                       `json:"total_pages"` // 総ページ数
+	Posts      []models.PostListResponse `json:"posts"`                 // 投稿一覧
+	Total      *int64                    `json:"total,omitempty"`       // 総件数（ページ番号指定時のみ）
+	Page       int                       `json:"page,omitempty"`        // 現在のページ（ページ番号指定時のみ）
+	Limit      int                       `json:"limit"`                 // 1ページあたりの件数
+	TotalPages *int                      `json:"total_pages,omitempty"` // 総ページ数（ページ番号指定時のみ）
+	NextCursor string                    `json:"next_cursor,omitempty"` // 続きを取得するカーソル（続きがない場合は空）
 }
 
 // postService は PostService インターフェースの実装です
 type postService struct {
-	postRepo    repositories.PostRepository    // 投稿データアクセス層
-	commentRepo repositories.CommentRepository // コメントデータアクセス層
-	validator   *validator.Validate            // バリデーター
+	postRepo     repositories.PostRepository     // 投稿データアクセス層
+	commentRepo  repositories.CommentRepository  // コメントデータアクセス層
+	reactionRepo repositories.ReactionRepository // リアクションデータアクセス層
+	companyRepo  repositories.CompanyRepository  // 企業データアクセス層
+	tagRepo      repositories.TagRepository      // タグデータアクセス層
+	categoryRepo repositories.CategoryRepository // カテゴリデータアクセス層
+	suggestIndex *search.SuggestIndex            // 企業名補完用のインデックス
+	validator    *validator.Validate             // バリデーター
 }
 
 // NewPostService は新しい PostService インスタンスを作成します
-func NewPostService(postRepo repositories.PostRepository, commentRepo repositories.CommentRepository, validator *validator.Validate) PostService {
+func NewPostService(postRepo repositories.PostRepository, commentRepo repositories.CommentRepository, reactionRepo repositories.ReactionRepository, companyRepo repositories.CompanyRepository, tagRepo repositories.TagRepository, categoryRepo repositories.CategoryRepository, suggestIndex *search.SuggestIndex, validator *validator.Validate) PostService {
 	return &postService{
-		postRepo:    postRepo,
-		commentRepo: commentRepo,
-		validator:   validator,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+		reactionRepo: reactionRepo,
+		companyRepo:  companyRepo,
+		tagRepo:      tagRepo,
+		categoryRepo: categoryRepo,
+		suggestIndex: suggestIndex,
+		validator:    validator,
 	}
 }
 
@@ -45,78 +60,125 @@ func NewPostService(postRepo repositories.PostRepository, commentRepo repositori
 func (s *postService) CreatePost(req *models.PostCreateRequest) (*models.PostResponse, error) {
 	// バリデーション
 	if err := s.validator.Struct(req); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+		return nil, validationError(err)
+	}
+
+	// 編集用トークンを生成
+	editToken, editTokenHash, err := generateToken()
+	if err != nil {
+		return nil, failedTo("generate edit token", err)
+	}
+
+	// 面接情報をカテゴリに応じて検証
+	interview, err := newPostInterview(s.categoryRepo, req.Category, req.Interview)
+	if err != nil {
+		return nil, err
+	}
+
+	// 企業名を企業に紐付け（表記ゆれは正式名に統一）
+	company, err := s.companyRepo.Resolve(req.CompanyName)
+	if err != nil {
+		return nil, failedTo("resolve company", err)
+	}
+
+	// タグを登録済みのタグに紐付け（表記ゆれは1つにまとめる）
+	tags, err := s.tagRepo.Resolve(req.Tags)
+	if err != nil {
+		return nil, failedTo("resolve tags", err)
 	}
 
 	// リクエストをモデルに変換
 	post := &models.Post{
-		Title:       req.Title,
-		Content:     req.Content,
-		Category:    req.Category,
-		CompanyName: req.CompanyName,
-		JobType:     req.JobType,
+		Title:         req.Title,
+		Content:       req.Content,
+		Category:      req.Category,
+		CompanyName:   company.Name,
+		CompanyID:     &company.ID,
+		JobType:       req.JobType,
+		EditTokenHash: editTokenHash,
+		Interview:     interview,
+		Tags:          tags,
 	}
 
 	// データベースに保存
 	if err := s.postRepo.Create(post); err != nil {
-		return nil, fmt.Errorf("failed to create post: %w", err)
+		return nil, failedTo("create post", err)
 	}
 
+	// 企業名補完のインデックスに反映
+	s.suggestIndex.RecordPost(company.ID, company.Name)
+
 	// レスポンスに変換
-	response := &models.PostResponse{
-		ID:          post.ID,
-		Title:       post.Title,
-		Content:     post.Content,
-		Category:    post.Category,
-		CompanyName: post.CompanyName,
-		JobType:     post.JobType,
-		CreatedAt:   post.CreatedAt,
-		UpdatedAt:   post.UpdatedAt,
-	}
+	response := newPostResponse(post)
+	response.EditToken = editToken
 
-	return response, nil
+	return &response, nil
 }
 
 // GetPost は指定されたIDの投稿詳細を取得します
-// 投稿に関連するコメントも含めて取得します
+// コメントは総数と新しい順の先頭スレッドのみを含めます
 func (s *postService) GetPost(id uint) (*models.PostDetailResponse, error) {
-	// 投稿とコメントを取得
-	post, err := s.postRepo.GetWithComments(id)
+	// 投稿を取得
+	post, err := s.postRepo.GetByID(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get post: %w", err)
-	}
-
-	// コメントをレスポンス形式に変換
-	comments := make([]models.CommentResponse, len(post.Comments))
-	for i, comment := range post.Comments {
-		comments[i] = models.CommentResponse{
-			ID:        comment.ID,
-			PostID:    comment.PostID,
-			Content:   comment.Content,
-			CreatedAt: comment.CreatedAt,
-			UpdatedAt: comment.UpdatedAt,
-		}
+		return nil, lookupError("get post", err, ErrPostNotFound)
+	}
+
+	// 投稿のリアクション件数を集計
+	postReactions, err := s.reactionRepo.CountByTarget(models.ReactionTargetPost, post.ID)
+	if err != nil {
+		return nil, failedTo("count reactions", err)
+	}
+
+	// 先頭のスレッドを取得
+	preview, err := loadCommentPage(s.commentRepo, s.reactionRepo, post.ID, models.CommentSortNewest, commentPreviewLimit, nil)
+	if err != nil {
+		return nil, err
 	}
 
 	// レスポンスに変換
 	response := &models.PostDetailResponse{
-		ID:          post.ID,
-		Title:       post.Title,
-		Content:     post.Content,
-		Category:    post.Category,
-		CompanyName: post.CompanyName,
-		JobType:     post.JobType,
-		CreatedAt:   post.CreatedAt,
-		UpdatedAt:   post.UpdatedAt,
-		Comments:    comments,
+		ID:              post.ID,
+		Title:           post.Title,
+		Content:         post.Content,
+		Category:        post.Category,
+		CompanyName:     post.CompanyName,
+		CompanyID:       post.CompanyID,
+		JobType:         post.JobType,
+		Interview:       newInterviewResponse(post.Interview),
+		Tags:            tagNames(post.Tags),
+		CreatedAt:       post.CreatedAt,
+		UpdatedAt:       post.UpdatedAt,
+		Reactions:       postReactions,
+		CommentCount:    post.CommentCount,
+		CommentsPreview: preview.Comments,
 	}
 
 	return response, nil
 }
 
 // GetPosts は投稿一覧を取得します
-// ページネーション、カテゴリフィルタ、企業名検索に対応しています
-func (s *postService) GetPosts(page, limit int, category, companyName string) (*PostListResult, error) {
+// ページネーション、filter による絞り込み・並び替え、キーワードによる全文検索に対応しています
+// キーワード検索時は並び順の指定が無ければ関連度順に並び、本文のマッチ箇所を含む抜粋を返します
+// cursor を指定した場合はページ番号の代わりにカーソル以降を取得します
+func (s *postService) GetPosts(filter models.PostFilter, page, limit int, cursor *pagination.Cursor) (*PostListResult, error) {
+	return s.listPosts(filter, page, limit, cursor)
+}
+
+// GetPostsByCompany は指定された企業の投稿一覧を新しい順に取得します
+func (s *postService) GetPostsByCompany(companyID uint, page, limit int, cursor *pagination.Cursor) (*PostListResult, error) {
+	// 企業が存在するかチェック
+	if _, err := s.companyRepo.GetByID(companyID); err != nil {
+		return nil, lookupError("get company", err, ErrCompanyNotFound)
+	}
+
+	filter := models.PostFilter{CompanyID: companyID, Sort: models.PostSortNewest}
+	return s.listPosts(filter, page, limit, cursor)
+}
+
+// listPosts は条件に一致する投稿一覧を取得し、レスポンス形式に変換します
+// 並び順が未指定の場合、キーワード検索時は関連度順、それ以外は新しい順に並べます
+func (s *postService) listPosts(filter models.PostFilter, page, limit int, cursor *pagination.Cursor) (*PostListResult, error) {
 	// ページネーション設定のバリデーション
 	if page <= 0 {
 		page = 1
@@ -125,42 +187,207 @@ func (s *postService) GetPosts(page, limit int, category, companyName string) (*
 		limit = 20
 	}
 
-	offset := (page - 1) * limit
+	var (
+		posts  []models.Post
+		result = &PostListResult{Limit: limit}
+	)
 
-	// 投稿一覧を取得
-	posts, total, err := s.postRepo.GetAll(limit, offset, category, companyName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get posts: %w", err)
+	// sort 未指定のキーワード検索は関連度順となり、カーソルでは続きを取得できない
+	relevance := filter.Sort == "" && filter.Keyword != "" && cursor == nil
+	if !relevance && filter.Sort == "" {
+		filter.Sort = models.PostSortNewest
 	}
 
-	// レスポンス形式に変換
-	postResponses := make([]models.PostListResponse, len(posts))
-	for i, post := range posts {
-		// 各投稿のコメント数を取得
-		commentCount, err := s.commentRepo.CountByPostID(post.ID)
+	if cursor != nil {
+		// カーソル指定時は1件多く取得して続きの有無を判定する
+		found, err := s.postRepo.GetAfter(filter, cursor, limit+1)
+		if err != nil {
+			return nil, failedTo("get posts", err)
+		}
+		posts = found
+		if len(posts) > limit {
+			posts = posts[:limit]
+			result.NextCursor = postCursor(filter.Sort, &posts[len(posts)-1])
+		}
+	} else {
+		offset := (page - 1) * limit
+
+		// 投稿一覧を取得
+		found, total, err := s.postRepo.GetAll(filter, limit, offset)
 		if err != nil {
-			commentCount = 0 // エラーの場合は0とする
+			return nil, failedTo("get posts", err)
+		}
+		posts = found
+
+		// 総ページ数を計算
+		totalPages := int((total + int64(limit) - 1) / int64(limit))
+		result.Total = &total
+		result.Page = page
+		result.TotalPages = &totalPages
+
+		// 関連度順以外の一覧では、続きをカーソルで取得できるようにする
+		if !relevance && len(posts) > 0 && int64(offset+len(posts)) < total {
+			result.NextCursor = postCursor(filter.Sort, &posts[len(posts)-1])
 		}
+	}
+
+	// 投稿のリアクション件数をまとめて集計
+	postIDs := make([]uint, len(posts))
+	for i, post := range posts {
+		postIDs[i] = post.ID
+	}
+	reactions, err := s.reactionRepo.CountByTargets(models.ReactionTargetPost, postIDs)
+	if err != nil {
+		return nil, failedTo("count reactions", err)
+	}
 
-		postResponses[i] = models.PostListResponse{
+	// レスポンス形式に変換
+	terms := search.ParseQuery(filter.Keyword)
+	result.Posts = make([]models.PostListResponse, len(posts))
+	for i, post := range posts {
+		result.Posts[i] = models.PostListResponse{
 			ID:           post.ID,
 			Title:        post.Title,
 			Category:     post.Category,
 			CompanyName:  post.CompanyName,
+			CompanyID:    post.CompanyID,
 			JobType:      post.JobType,
+			Interview:    newInterviewResponse(post.Interview),
+			Tags:         tagNames(post.Tags),
 			CreatedAt:    post.CreatedAt,
-			CommentCount: commentCount,
+			BumpedAt:     post.BumpedAt,
+			CommentCount: post.CommentCount,
+			Reactions:    reactions[post.ID],
+		}
+		if len(terms) > 0 {
+			result.Posts[i].Snippet = search.Snippet(post.Content, terms)
+		}
+	}
+
+	return result, nil
+}
+
+// postCursor は投稿の直後から続きを取得するカーソル文字列を返します
+func postCursor(sort string, post *models.Post) string {
+	var key int64
+	switch sort {
+	case models.PostSortComments:
+		key = post.CommentCount
+	case models.PostSortBumped:
+		key = pagination.TimeKey(post.BumpedAt)
+	case models.PostSortReactions:
+		key = post.ReactionCount
+	default:
+		key = pagination.TimeKey(post.CreatedAt)
+	}
+	return pagination.New(sort, key, post.ID).Encode()
+}
+
+// UpdatePost は投稿を更新します
+// 編集用トークンを検証した後、投稿内容を上書きします
+func (s *postService) UpdatePost(id uint, editToken string, req *models.PostUpdateRequest) (*models.PostResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+
+	// 投稿を取得（非表示になった投稿も投稿者は編集できる）
+	post, err := s.postRepo.GetByIDIncludingHidden(id)
+	if err != nil {
+		return nil, lookupError("get post", err, ErrPostNotFound)
+	}
+
+	// 編集用トークンを検証
+	if !verifyEditToken(post.EditTokenHash, editToken) {
+		return nil, ErrInvalidEditToken
+	}
+
+	// 面接情報をカテゴリに応じて検証
+	interview, err := newPostInterview(s.categoryRepo, req.Category, req.Interview)
+	if err != nil {
+		return nil, err
+	}
+
+	// 企業名を企業に紐付け（表記ゆれは正式名に統一）
+	company, err := s.companyRepo.Resolve(req.CompanyName)
+	if err != nil {
+		return nil, failedTo("resolve company", err)
+	}
+
+	// タグを登録済みのタグに紐付け（表記ゆれは1つにまとめる）
+	tags, err := s.tagRepo.Resolve(req.Tags)
+	if err != nil {
+		return nil, failedTo("resolve tags", err)
+	}
+
+	// 内容を更新
+	previousCompanyID := post.CompanyID
+	post.Title = req.Title
+	post.Content = req.Content
+	post.Category = req.Category
+	post.CompanyName = company.Name
+	post.CompanyID = &company.ID
+	post.JobType = req.JobType
+	post.Interview = interview
+	post.Tags = tags
+
+	if err := s.postRepo.Update(post); err != nil {
+		return nil, failedTo("update post", err)
+	}
+
+	// 企業が変わった場合は企業名補完のインデックスに反映（非表示の投稿は投稿数に含まれない）
+	if post.HiddenAt == nil && (previousCompanyID == nil || *previousCompanyID != company.ID) {
+		if previousCompanyID != nil {
+			s.suggestIndex.RemovePost(*previousCompanyID)
 		}
+		s.suggestIndex.RecordPost(company.ID, company.Name)
 	}
 
-	// 総ページ数を計算
-	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	// レスポンスに変換
+	response := newPostResponse(post)
 
-	return &PostListResult{
-		Posts:      postResponses,
-		Total:      total,
-		Page:       page,
-		Limit:      limit,
-		TotalPages: totalPages,
-	}, nil
+	return &response, nil
+}
+
+// DeletePost は投稿を削除します
+// 編集用トークンを検証した後、論理削除を行います
+func (s *postService) DeletePost(id uint, editToken string) error {
+	// 投稿を取得（非表示になった投稿も投稿者は削除できる）
+	post, err := s.postRepo.GetByIDIncludingHidden(id)
+	if err != nil {
+		return lookupError("get post", err, ErrPostNotFound)
+	}
+
+	// 編集用トークンを検証
+	if !verifyEditToken(post.EditTokenHash, editToken) {
+		return ErrInvalidEditToken
+	}
+
+	if err := s.postRepo.Delete(post.ID); err != nil {
+		return failedTo("delete post", err)
+	}
+
+	// 企業名補完のインデックスに反映（非表示の投稿は投稿数に含まれない）
+	if post.HiddenAt == nil && post.CompanyID != nil {
+		s.suggestIndex.RemovePost(*post.CompanyID)
+	}
+
+	return nil
+}
+
+// newPostResponse は投稿モデルをレスポンス形式に変換します
+func newPostResponse(post *models.Post) models.PostResponse {
+	return models.PostResponse{
+		ID:          post.ID,
+		Title:       post.Title,
+		Content:     post.Content,
+		Category:    post.Category,
+		CompanyName: post.CompanyName,
+		CompanyID:   post.CompanyID,
+		JobType:     post.JobType,
+		Interview:   newInterviewResponse(post.Interview),
+		Tags:        tagNames(post.Tags),
+		CreatedAt:   post.CreatedAt,
+		UpdatedAt:   post.UpdatedAt,
+	}
 }
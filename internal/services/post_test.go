@@ -0,0 +1,130 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// queryCounter は GORM が発行した SQL の数を数えるコールバックを登録した DB です
+type queryCounter struct {
+	db    *gorm.DB
+	mock  sqlmock.Sqlmock
+	count atomic.Int64
+}
+
+// newQueryCounter は sqlmock をデータベースとして使用する queryCounter を作成します
+func newQueryCounter(tb testing.TB) *queryCounter {
+	tb.Helper()
+	sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger:                 logger.Discard,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	c := &queryCounter{db: db, mock: mock}
+	count := func(*gorm.DB) { c.count.Add(1) }
+	callbacks := db.Callback()
+	if err := callbacks.Query().After("gorm:query").Register("test:count_query", count); err != nil {
+		tb.Fatal(err)
+	}
+	if err := callbacks.Row().After("gorm:row").Register("test:count_row", count); err != nil {
+		tb.Fatal(err)
+	}
+	if err := callbacks.Raw().After("gorm:raw").Register("test:count_raw", count); err != nil {
+		tb.Fatal(err)
+	}
+	return c
+}
+
+// expectPostPage は n 件の投稿（それぞれタグ1件）を返す一覧取得の SQL を登録します
+func (c *queryCounter) expectPostPage(n int) {
+	now := time.Now()
+	posts := sqlmock.NewRows([]string{"id", "title", "content", "category", "company_name", "created_at", "updated_at", "bumped_at"})
+	postTags := sqlmock.NewRows([]string{"post_id", "tag_id"})
+	for i := 1; i <= n; i++ {
+		posts.AddRow(i, fmt.Sprintf("title %d", i), "content", "other", "company", now, now, now)
+		postTags.AddRow(i, 1)
+	}
+
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "posts"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(n))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "posts"`)).WillReturnRows(posts)
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_interviews"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "post_id"}))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "post_tags"`)).WillReturnRows(postTags)
+	c.mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "tag"))
+	c.mock.ExpectQuery(regexp.QuoteMeta(`FROM "reactions"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "kind", "count"}).AddRow(1, models.ReactionKinds[0], 1))
+}
+
+// getPostPage は 1ページ目を n 件で取得し、発行した SQL の数を返します
+func (c *queryCounter) getPostPage(tb testing.TB, service PostService, n int) int64 {
+	tb.Helper()
+	c.expectPostPage(n)
+	c.count.Store(0)
+
+	result, err := service.GetPosts(models.PostFilter{}, 1, n, nil)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if len(result.Posts) != n {
+		tb.Fatalf("got %d posts, want %d", len(result.Posts), n)
+	}
+	if err := c.mock.ExpectationsWereMet(); err != nil {
+		tb.Fatal(err)
+	}
+	return c.count.Load()
+}
+
+// newListPostService は一覧取得に必要なリポジトリだけを持つ PostService を作成します
+func newListPostService(db *gorm.DB) PostService {
+	return NewPostService(repositories.NewPostRepository(db), nil, repositories.NewReactionRepository(db), nil, nil, nil, nil, nil)
+}
+
+// TestGetPostsQueryCount は投稿一覧の SQL の数が件数によらず一定であることを確認します
+func TestGetPostsQueryCount(t *testing.T) {
+	c := newQueryCounter(t)
+	service := newListPostService(c.db)
+
+	want := c.getPostPage(t, service, 1)
+	for _, n := range []int{20, 100} {
+		if got := c.getPostPage(t, service, n); got != want {
+			t.Errorf("%d posts: issued %d queries, want %d (same as 1 post)", n, got, want)
+		}
+	}
+}
+
+// BenchmarkGetPosts は1ページあたりの件数ごとに投稿一覧の取得を計測し、1回あたりの SQL の数を報告します
+func BenchmarkGetPosts(b *testing.B) {
+	for _, n := range []int{1, 20, 100} {
+		b.Run(fmt.Sprintf("limit=%d", n), func(b *testing.B) {
+			c := newQueryCounter(b)
+			service := newListPostService(c.db)
+
+			var queries int64
+			for i := 0; i < b.N; i++ {
+				queries = c.getPostPage(b, service, n)
+			}
+			b.ReportMetric(float64(queries), "queries/op")
+		})
+	}
+}
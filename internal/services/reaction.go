@@ -0,0 +1,118 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+)
+
+var (
+	// ErrInvalidReactionKind はリアクションの種類が不正な場合のエラーです
+	ErrInvalidReactionKind = errors.New("invalid reaction kind")
+	// ErrClientIDRequired はクライアント識別子が取得できない場合のエラーです
+	ErrClientIDRequired = errors.New("client identity is required")
+)
+
+// ReactionService はリアクションに関するビジネスロジックを定義するインターフェースです
+type ReactionService interface {
+	AddReaction(targetType string, targetID uint, kind, clientID string) (*models.ReactionResponse, error)
+	RemoveReaction(targetType string, targetID uint, kind, clientID string) (*models.ReactionResponse, error)
+}
+
+// reactionService は ReactionService インターフェースの実装です
+type reactionService struct {
+	reactionRepo repositories.ReactionRepository // リアクションデータアクセス層
+	postRepo     repositories.PostRepository     // 投稿データアクセス層
+	commentRepo  repositories.CommentRepository  // コメントデータアクセス層
+}
+
+// NewReactionService は新しい ReactionService インスタンスを作成します
+func NewReactionService(reactionRepo repositories.ReactionRepository, postRepo repositories.PostRepository, commentRepo repositories.CommentRepository) ReactionService {
+	return &reactionService{
+		reactionRepo: reactionRepo,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+	}
+}
+
+// AddReaction はリアクションを追加します
+// 同じクライアントが同じ種類のリアクションを重ねて付けても件数は増えません
+func (s *reactionService) AddReaction(targetType string, targetID uint, kind, clientID string) (*models.ReactionResponse, error) {
+	if err := s.checkTarget(targetType, targetID, kind, clientID); err != nil {
+		return nil, err
+	}
+
+	reaction := &models.Reaction{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Kind:       kind,
+		ClientHash: hashClientID(clientID),
+	}
+
+	if err := s.reactionRepo.Add(reaction); err != nil {
+		return nil, failedTo("add reaction", err)
+	}
+
+	return s.buildResponse(targetType, targetID, reaction.ClientHash)
+}
+
+// RemoveReaction はリアクションを取り消します
+// 付けていないリアクションを取り消しても成功として扱います
+func (s *reactionService) RemoveReaction(targetType string, targetID uint, kind, clientID string) (*models.ReactionResponse, error) {
+	if err := s.checkTarget(targetType, targetID, kind, clientID); err != nil {
+		return nil, err
+	}
+
+	clientHash := hashClientID(clientID)
+	if err := s.reactionRepo.Remove(targetType, targetID, kind, clientHash); err != nil {
+		return nil, failedTo("remove reaction", err)
+	}
+
+	return s.buildResponse(targetType, targetID, clientHash)
+}
+
+// checkTarget はリアクションの種類・クライアント識別子・対象の存在を確認します
+func (s *reactionService) checkTarget(targetType string, targetID uint, kind, clientID string) error {
+	if !models.IsValidReactionKind(kind) {
+		return ErrInvalidReactionKind
+	}
+	if clientID == "" {
+		return ErrClientIDRequired
+	}
+
+	switch targetType {
+	case models.ReactionTargetPost:
+		if _, err := s.postRepo.GetByID(targetID); err != nil {
+			return lookupError("get post", err, ErrPostNotFound)
+		}
+	case models.ReactionTargetComment:
+		if _, err := s.commentRepo.GetByID(targetID); err != nil {
+			return lookupError("get comment", err, ErrCommentNotFound)
+		}
+	default:
+		return fmt.Errorf("unknown reaction target: %s", targetType)
+	}
+	return nil
+}
+
+// buildResponse は対象の最新の集計結果からレスポンスを作成します
+func (s *reactionService) buildResponse(targetType string, targetID uint, clientHash string) (*models.ReactionResponse, error) {
+	counts, err := s.reactionRepo.CountByTarget(targetType, targetID)
+	if err != nil {
+		return nil, failedTo("count reactions", err)
+	}
+
+	reacted, err := s.reactionRepo.GetKindsByClient(targetType, targetID, clientHash)
+	if err != nil {
+		return nil, failedTo("get reactions", err)
+	}
+
+	return &models.ReactionResponse{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reactions:  counts,
+		Reacted:    reacted,
+	}, nil
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+)
+
+var (
+	// ErrAlreadyReported は同じクライアントが同じ対象を通報済みの場合のエラーです
+	ErrAlreadyReported = errors.New("already reported")
+	// ErrReportAlreadyResolved は対応済みの通報を再度処理しようとした場合のエラーです
+	ErrReportAlreadyResolved = errors.New("report already resolved")
+)
+
+// ReportService は通報に関するビジネスロジックを定義するインターフェースです
+type ReportService interface {
+	CreateReport(targetType string, targetID uint, clientID, remoteIP string, req *models.ReportCreateRequest) (*models.ReportResponse, error)
+	GetReports(page, limit int, status string) (*ReportListResult, error)
+}
+
+// ReportListResult は通報一覧取得の結果を表す構造体です
+type ReportListResult struct {
+	Reports    []models.ReportResponse `json:"reports"`     // 通報一覧
+	Total      int64                   `json:"total"`       // 総件数
+	Page       int                     `json:"page"`        // 現在のページ
+	Limit      int                     `json:"limit"`       // 1ページあたりの件数
+	TotalPages int                     `json:"total_pages"` // 総ページ数
+}
+
+// reportService は ReportService インターフェースの実装です
+type reportService struct {
+	reportRepo     repositories.ReportRepository  // 通報データアクセス層
+	postRepo       repositories.PostRepository    // 投稿データアクセス層
+	commentRepo    repositories.CommentRepository // コメントデータアクセス層
+	companyService CompanyService                 // 企業名補完用インデックスの再構築に使用
+	validator      *validator.Validate            // バリデーター
+	hideThreshold  int64                          // 自動非表示にする未対応通報数
+}
+
+// NewReportService は新しい ReportService インスタンスを作成します
+// hideThreshold 件以上の未対応通報が集まった対象は自動的に非表示になります（0以下で無効）
+func NewReportService(reportRepo repositories.ReportRepository, postRepo repositories.PostRepository, commentRepo repositories.CommentRepository, companyService CompanyService, validator *validator.Validate, hideThreshold int) ReportService {
+	return &reportService{
+		reportRepo:     reportRepo,
+		postRepo:       postRepo,
+		commentRepo:    commentRepo,
+		companyService: companyService,
+		validator:      validator,
+		hideThreshold:  int64(hideThreshold),
+	}
+}
+
+// CreateReport は投稿・コメントへの通報を登録します
+// 未対応の通報を送った接続元IPアドレスの数がしきい値に達した場合、対象を自動的に非表示にします
+func (s *reportService) CreateReport(targetType string, targetID uint, clientID, remoteIP string, req *models.ReportCreateRequest) (*models.ReportResponse, error) {
+	// バリデーション
+	if err := s.validator.Struct(req); err != nil {
+		return nil, validationError(err)
+	}
+	if clientID == "" {
+		return nil, ErrClientIDRequired
+	}
+
+	// 対象が存在するかチェック
+	switch targetType {
+	case models.ReportTargetPost:
+		if _, err := s.postRepo.GetByID(targetID); err != nil {
+			return nil, lookupError("get post", err, ErrPostNotFound)
+		}
+	case models.ReportTargetComment:
+		if _, err := s.commentRepo.GetByID(targetID); err != nil {
+			return nil, lookupError("get comment", err, ErrCommentNotFound)
+		}
+	default:
+		return nil, fmt.Errorf("unknown report target: %s", targetType)
+	}
+
+	// リクエストをモデルに変換
+	report := &models.Report{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     req.Reason,
+		Detail:     req.Detail,
+		ClientHash: hashClientID(clientID),
+		IPHash:     hashClientID("ip:" + remoteIP),
+		Status:     models.ReportStatusOpen,
+	}
+
+	// データベースに保存
+	created, err := s.reportRepo.Create(report)
+	if err != nil {
+		return nil, failedTo("create report", err)
+	}
+	if !created {
+		return nil, ErrAlreadyReported
+	}
+
+	// しきい値に達した場合は自動的に非表示にする
+	// 同じ接続元からの通報は、クライアント識別子を変えても1件として数える
+	if s.hideThreshold > 0 {
+		count, err := s.reportRepo.CountOpenReporters(targetType, targetID)
+		if err != nil {
+			return nil, failedTo("count reports", err)
+		}
+		if count >= s.hideThreshold {
+			if err := setReportTargetHidden(s.postRepo, s.commentRepo, targetType, targetID, true); err != nil {
+				return nil, failedTo("hide reported content", err)
+			}
+			// 非表示の投稿は企業の投稿数に含めないため、補完用インデックスを作り直す
+			if targetType == models.ReportTargetPost {
+				if err := s.companyService.RefreshSuggestIndex(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	response := newReportResponse(report)
+	return &response, nil
+}
+
+// GetReports は通報一覧を取得します
+// status を指定すると対応状況で絞り込みます（未対応のキューは "open"）
+func (s *reportService) GetReports(page, limit int, status string) (*ReportListResult, error) {
+	// ページネーション設定のバリデーション
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	offset := (page - 1) * limit
+
+	reports, total, err := s.reportRepo.GetAll(limit, offset, status)
+	if err != nil {
+		return nil, failedTo("get reports", err)
+	}
+
+	responses := make([]models.ReportResponse, len(reports))
+	for i := range reports {
+		responses[i] = newReportResponse(&reports[i])
+	}
+
+	// 総ページ数を計算
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &ReportListResult{
+		Reports:    responses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// setReportTargetHidden は通報対象の非表示状態を切り替えます
+func setReportTargetHidden(postRepo repositories.PostRepository, commentRepo repositories.CommentRepository, targetType string, targetID uint, hidden bool) error {
+	switch targetType {
+	case models.ReportTargetPost:
+		return postRepo.SetHidden(targetID, hidden)
+	case models.ReportTargetComment:
+		return commentRepo.SetHidden(targetID, hidden)
+	default:
+		return fmt.Errorf("unknown report target: %s", targetType)
+	}
+}
+
+// newReportResponse は通報モデルをレスポンス形式に変換します
+func newReportResponse(report *models.Report) models.ReportResponse {
+	return models.ReportResponse{
+		ID:             report.ID,
+		TargetType:     report.TargetType,
+		TargetID:       report.TargetID,
+		Reason:         report.Reason,
+		Detail:         report.Detail,
+		Status:         report.Status,
+		ResolutionNote: report.ResolutionNote,
+		ResolvedAt:     report.ResolvedAt,
+		CreatedAt:      report.CreatedAt,
+	}
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/validators"
+)
+
+// fakeReportRepository は通報をメモリ上に保持する ReportRepository です
+type fakeReportRepository struct {
+	repositories.ReportRepository
+	reports []models.Report
+}
+
+func (r *fakeReportRepository) Create(report *models.Report) (bool, error) {
+	for _, existing := range r.reports {
+		if existing.TargetType == report.TargetType && existing.TargetID == report.TargetID && existing.ClientHash == report.ClientHash {
+			return false, nil
+		}
+	}
+	report.ID = uint(len(r.reports) + 1)
+	r.reports = append(r.reports, *report)
+	return true, nil
+}
+
+func (r *fakeReportRepository) CountOpenReporters(targetType string, targetID uint) (int64, error) {
+	reporters := make(map[string]bool)
+	for _, report := range r.reports {
+		if report.TargetType == targetType && report.TargetID == targetID && report.Status == models.ReportStatusOpen {
+			reporters[report.IPHash] = true
+		}
+	}
+	return int64(len(reporters)), nil
+}
+
+// fakePostRepository は存在する投稿と非表示にした投稿を記録する PostRepository です
+type fakePostRepository struct {
+	repositories.PostRepository
+	hidden map[uint]bool
+}
+
+func (r *fakePostRepository) GetByID(id uint) (*models.Post, error) {
+	return &models.Post{ID: id}, nil
+}
+
+func (r *fakePostRepository) SetHidden(id uint, hidden bool) error {
+	r.hidden[id] = hidden
+	return nil
+}
+
+// fakeCompanyService は補完用インデックスの再構築の回数を数える CompanyService です
+type fakeCompanyService struct {
+	CompanyService
+	refreshed int
+}
+
+func (s *fakeCompanyService) RefreshSuggestIndex() error {
+	s.refreshed++
+	return nil
+}
+
+// newTestReportService はメモリ上のリポジトリを使用する ReportService を作成します
+func newTestReportService(t *testing.T, hideThreshold int) (ReportService, *fakePostRepository, *fakeCompanyService) {
+	t.Helper()
+	validate, err := validators.NewValidate(validators.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	postRepo := &fakePostRepository{hidden: make(map[uint]bool)}
+	companyService := &fakeCompanyService{}
+	return NewReportService(&fakeReportRepository{}, postRepo, nil, companyService, validate, hideThreshold), postRepo, companyService
+}
+
+// TestCreateReportSameIP はクライアント識別子を変えても、同じ接続元からの通報では自動的に非表示にならないことを確認します
+func TestCreateReportSameIP(t *testing.T) {
+	const threshold = 3
+	service, postRepo, _ := newTestReportService(t, threshold)
+
+	for i := 0; i < threshold*2; i++ {
+		clientID := fmt.Sprintf("client:generated-%d", i)
+		if _, err := service.CreateReport(models.ReportTargetPost, 1, clientID, "203.0.113.1", &models.ReportCreateRequest{Reason: "spam"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if postRepo.hidden[1] {
+		t.Error("reports from a single IP address hid the post")
+	}
+}
+
+// TestCreateReportDistinctIPs は異なる接続元からの通報がしきい値に達すると自動的に非表示になり、
+// 企業名補完用インデックスを作り直すことを確認します
+func TestCreateReportDistinctIPs(t *testing.T) {
+	const threshold = 3
+	service, postRepo, companyService := newTestReportService(t, threshold)
+
+	for i := 0; i < threshold; i++ {
+		if postRepo.hidden[1] {
+			t.Fatalf("the post was hidden after %d reports", i)
+		}
+		clientID := fmt.Sprintf("client:%d", i)
+		ip := fmt.Sprintf("203.0.113.%d", i+1)
+		if _, err := service.CreateReport(models.ReportTargetPost, 1, clientID, ip, &models.ReportCreateRequest{Reason: "spam"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !postRepo.hidden[1] {
+		t.Error("the post was not hidden after reports from distinct IP addresses reached the threshold")
+	}
+	if companyService.refreshed != 1 {
+		t.Errorf("suggest index refreshed %d times, want 1", companyService.refreshed)
+	}
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+)
+
+// TagService はタグに関するビジネスロジックを定義するインターフェースです
+type TagService interface {
+	GetTags(limit int, keyword string) ([]models.TagResponse, error)
+}
+
+// tagService は TagService インターフェースの実装です
+type tagService struct {
+	tagRepo repositories.TagRepository // タグデータアクセス層
+}
+
+// NewTagService は新しい TagService インスタンスを作成します
+func NewTagService(tagRepo repositories.TagRepository) TagService {
+	return &tagService{
+		tagRepo: tagRepo,
+	}
+}
+
+// GetTags は公開中の投稿に付いているタグを投稿数の多い順に取得します
+// keyword を指定すると表記ゆれを吸収した部分一致で絞り込みます
+func (s *tagService) GetTags(limit int, keyword string) ([]models.TagResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	tags, err := s.tagRepo.GetAll(limit, keyword)
+	if err != nil {
+		return nil, failedTo("get tags", err)
+	}
+
+	responses := make([]models.TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = models.TagResponse{
+			ID:        tag.ID,
+			Name:      tag.Name,
+			PostCount: tag.PostCount,
+		}
+	}
+	return responses, nil
+}
+
+// tagNames は投稿に付いているタグの表示名を返します
+func tagNames(tags []models.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
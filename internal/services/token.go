@@ -0,0 +1,52 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+)
+
+// ErrInvalidEditToken は編集用トークンが一致しない場合のエラーです
+var ErrInvalidEditToken = errors.New("invalid edit token")
+
+// tokenBytes は編集用トークン・セッショントークンのバイト長です
+const tokenBytes = 32
+
+// generateToken はランダムなシークレットトークンとそのハッシュ値を生成します
+// トークンはクライアントに一度だけ返し、データベースにはハッシュ値のみを保存します
+func generateToken() (token string, hash string, err error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(buf)
+	return token, hashToken(token), nil
+}
+
+// hashToken はトークンの SHA-256 ハッシュ値を返します
+func hashToken(token string) string {
+	return sha256Hex(token)
+}
+
+// hashClientID は匿名クライアント識別子の SHA-256 ハッシュ値を返します
+// IPアドレス等の識別子をそのまま保存しないために使用します
+func hashClientID(clientID string) string {
+	return sha256Hex(clientID)
+}
+
+// sha256Hex は文字列の SHA-256 ハッシュ値を16進数文字列で返します
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyEditToken はトークンが保存済みのハッシュ値と一致するか検証します
+// ハッシュ値が未設定の場合（トークン導入前のデータ）は常に false を返します
+func verifyEditToken(hash, token string) bool {
+	if hash == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(hashToken(token))) == 1
+}
@@ -0,0 +1,71 @@
+package validators
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// CategoryLoader は有効なカテゴリ名の一覧を取得する関数です
+type CategoryLoader func() ([]string, error)
+
+// CategorySet はデータベース上の有効なカテゴリ名を一定時間キャッシュします
+// キャッシュの有効期限が切れると次の判定時に読み込み直します
+type CategorySet struct {
+	load     CategoryLoader
+	ttl      time.Duration
+	mu       sync.Mutex
+	names    map[string]struct{}
+	loadedAt time.Time
+}
+
+// NewCategorySet は新しい CategorySet を作成します
+func NewCategorySet(load CategoryLoader, ttl time.Duration) *CategorySet {
+	return &CategorySet{load: load, ttl: ttl}
+}
+
+// Contains は name が有効なカテゴリかどうかを返します
+// 読み込みに失敗した場合は前回読み込んだ一覧で判定します
+func (s *CategorySet) Contains(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.names == nil || time.Since(s.loadedAt) > s.ttl {
+		if err := s.reload(); err != nil {
+			log.Printf("Failed to load categories: %v", err)
+		}
+	}
+	_, ok := s.names[name]
+	return ok
+}
+
+// Invalidate はキャッシュを破棄し、次の判定時に読み込み直すようにします
+func (s *CategorySet) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadedAt = time.Time{}
+}
+
+// reload はカテゴリ名の一覧を読み込み直します（呼び出し元でロックを取得すること）
+func (s *CategorySet) reload() error {
+	names, err := s.load()
+	if err != nil {
+		return err
+	}
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	s.names = set
+	s.loadedAt = time.Now()
+	return nil
+}
+
+// RegisterCategory は有効なカテゴリかどうかを判定する "category" タグを登録します
+func RegisterCategory(v *validator.Validate, categories *CategorySet) error {
+	return v.RegisterValidation("category", func(fl validator.FieldLevel) bool {
+		return categories.Contains(fl.Field().String())
+	})
+}
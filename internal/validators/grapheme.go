@@ -0,0 +1,9 @@
+package validators
+
+import "github.com/rivo/uniseg"
+
+// GraphemeCount は文字列を利用者が1文字と認識する単位（UAX #29 の拡張書記素クラスター）で数えます
+// 結合文字・異体字セレクター・肌の色の修飾子・ZWJ で結合した絵文字・国旗は1文字として数えます
+func GraphemeCount(s string) int {
+	return uniseg.GraphemeClusterCount(s)
+}
@@ -0,0 +1,80 @@
+package validators
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestGraphemeCount は結合文字・絵文字・国旗を1文字として数えることを確認します
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "abc", 3},
+		{"japanese", "日本語", 3},
+		{"combining dakuten", "か\u3099", 1},
+		{"combining acute", "e\u0301", 1},
+		{"variation selector", "❤\ufe0f", 1},
+		{"skin tone modifier", "👍🏽", 1},
+		{"zwj sequence", "👨\u200d👩\u200d👧", 1},
+		{"flags", "🇯🇵🇺🇸", 2},
+		{"odd regional indicator", "🇯🇵🇺", 2},
+		{"subdivision flag", "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", 1},
+		{"hangul jamo", "\u1100\u1161\u11a8", 1},
+		{"crlf", "a\r\nb", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GraphemeCount(tt.in); got != tt.want {
+				t.Errorf("GraphemeCount(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTextLength は連続する空白を1文字とし、前後の空白を除いて数えることを確認します
+func TestTextLength(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"  ", 0},
+		{" a  b\n\nc ", 5},
+		{"👍🏽 ok", 4},
+	}
+	for _, tt := range tests {
+		if got := TextLength(tt.in); got != tt.want {
+			t.Errorf("TextLength(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestTextMaxCombiningFlood は結合文字を大量に重ねた文字列を text_max で拒否することを確認します
+func TestTextMaxCombiningFlood(t *testing.T) {
+	v, err := NewValidate(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		in    string
+		valid bool
+	}{
+		{"family emoji", strings.Repeat("👨\u200d👩\u200d👧\u200d👦", 5), true},
+		{"combining dakuten", strings.Repeat("か\u3099", 5), true},
+		{"combining flood", "a" + strings.Repeat("\u0301", 10000), false},
+		{"too many characters", strings.Repeat("a", 6), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.in, "text_max=5")
+			if valid := err == nil; valid != tt.valid {
+				t.Errorf("valid = %v, want %v (err = %v)", valid, tt.valid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeText は入力された文字列を保存・検証用に正規化します
+//   - NFKC 正規化（全角英数字・記号は半角に、半角カナは全角に統一）
+//   - 改行は LF に統一し、改行・タブ以外の制御文字を除く
+//   - ゼロ幅スペース・単語結合子・BOM を除く（絵文字の結合に使う ZWJ は残す）
+//   - 前後の空白を除く
+func NormalizeText(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = norm.NFKC.String(s)
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\r':
+			return '\n'
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r), r == 0x200B, r == 0x2060, r == 0xFEFF:
+			return -1
+		}
+		return r
+	}, s)
+	return strings.TrimSpace(s)
+}
+
+// Normalize は構造体（へのポインター）の文字列の項目を NormalizeText で正規化します
+// ネストした構造体・スライスの要素も対象にします。パスワードなど値を変えてはいけない項目には `normalize:"-"` を指定します
+func Normalize(v interface{}) {
+	normalizeValue(reflect.ValueOf(v))
+}
+
+// normalizeValue は値に含まれる文字列を再帰的に正規化します
+func normalizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			normalizeValue(v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if !t.Field(i).IsExported() || t.Field(i).Tag.Get("normalize") == "-" {
+				continue
+			}
+			normalizeValue(v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeValue(v.Index(i))
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(NormalizeText(v.String()))
+		}
+	}
+}
+
+// Binder はリクエストをバインドした後、文字列の項目を正規化する Echo のバインダーです
+// 検証（c.Validate）とサービス層での保存は正規化した値に対して行われます
+type Binder struct {
+	echo.DefaultBinder
+}
+
+// Bind はリクエストを i にバインドし、文字列の項目を正規化します
+func (b *Binder) Bind(i interface{}, c echo.Context) error {
+	if err := b.DefaultBinder.Bind(i, c); err != nil {
+		return err
+	}
+	Normalize(i)
+	return nil
+}
+
+// NewBinder は新しいバインダーのインスタンスを作成します
+func NewBinder() echo.Binder {
+	return &Binder{}
+}
@@ -0,0 +1,57 @@
+package validators
+
+import "testing"
+
+// TestNormalizeText は保存・検証前の正規化の内容を確認します
+func TestNormalizeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"full-width alphanumerics", "ＡＢＣ１２３", "ABC123"},
+		{"half-width katakana", "ｶﾀｶﾅ", "カタカナ"},
+		{"crlf", "a\r\nb\rc", "a\nb\nc"},
+		{"control characters", "a\x00b\x07c\td", "abc\td"},
+		{"zero-width characters", "a\u200bb\u2060c\ufeffd", "abcd"},
+		{"keeps zwj in emoji", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧"},
+		{"trims spaces", "  　text\n ", "text"},
+		{"invalid utf-8", "a\xffb", "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeText(tt.in); got != tt.want {
+				t.Errorf("NormalizeText(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNormalize はネストした項目も正規化し、除外した項目は変更しないことを確認します
+func TestNormalize(t *testing.T) {
+	type inner struct {
+		Stage string
+	}
+	req := struct {
+		Title      string
+		Tags       []string
+		Interview  *inner
+		Password   string `normalize:"-"`
+		unexported string
+	}{
+		Title:      " ＴＩＴＬＥ ",
+		Tags:       []string{"ﾀｸﾞ "},
+		Interview:  &inner{Stage: "一次\u200b"},
+		Password:   " ＰＡＳＳ ",
+		unexported: " ｘ ",
+	}
+
+	Normalize(&req)
+
+	if req.Title != "TITLE" || req.Tags[0] != "タグ" || req.Interview.Stage != "一次" {
+		t.Errorf("strings were not normalized: %+v, %+v", req, *req.Interview)
+	}
+	if req.Password != " ＰＡＳＳ " || req.unexported != " ｘ " {
+		t.Errorf("excluded fields were changed: %q, %q", req.Password, req.unexported)
+	}
+}
@@ -0,0 +1,128 @@
+package validators
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Options はプロジェクト固有のルールの設定です
+type Options struct {
+	Categories *CategorySet // "category" の判定に使用する有効なカテゴリ
+	NGWords    []string     // "no_ng_word" で禁止する語句（大文字・小文字、全角・半角は区別しない）
+}
+
+// NewValidate はプロジェクト固有のルールを登録したバリデーターを作成します
+// サービス層と Echo（New でラップしたもの）の両方に同じインスタンスを渡して使用します
+//
+// 登録するルール:
+//   - category: データベース上の有効なカテゴリ
+//   - text_min・text_max: 連続する空白を1文字として数え、前後の空白を除いた文字数（書記素クラスター単位）
+//     text_max はコードポイント数も上限の MaxRunesPerCharacter 倍までに制限します
+//   - no_url_only: URL だけの文章でないこと
+//   - no_ng_word: 禁止語句を含まないこと
+func NewValidate(opts Options) (*validator.Validate, error) {
+	v := validator.New()
+	RegisterJSONFieldNames(v)
+
+	if opts.Categories != nil {
+		if err := RegisterCategory(v, opts.Categories); err != nil {
+			return nil, err
+		}
+	}
+
+	ngWords := make([]string, 0, len(opts.NGWords))
+	for _, word := range opts.NGWords {
+		if word = foldText(word); word != "" {
+			ngWords = append(ngWords, word)
+		}
+	}
+
+	rules := map[string]validator.Func{
+		"text_min":    textMin,
+		"text_max":    textMax,
+		"no_url_only": noURLOnly,
+		"no_ng_word": func(fl validator.FieldLevel) bool {
+			return !containsNGWord(fl.Field().String(), ngWords)
+		},
+	}
+	for tag, fn := range rules {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// TextLength は前後の空白を除き、連続する空白（改行を含む）を1文字として数えた文字数を返します
+// 文字数は GraphemeCount で数えるため、絵文字や結合文字も見た目どおり1文字になります
+func TextLength(s string) int {
+	return GraphemeCount(strings.Join(strings.Fields(s), " "))
+}
+
+// textMin は TextLength がパラメーター以上かどうかを判定します
+func textMin(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		panic("validators: invalid text_min parameter: " + fl.Param())
+	}
+	return TextLength(fl.Field().String()) >= limit
+}
+
+// MaxRunesPerCharacter は text_max で1文字あたりに許可するコードポイント数です
+// 肌の色の修飾子や ZWJ で結合した絵文字は収まり、結合文字を大量に重ねた文字列は上限を超えます
+const MaxRunesPerCharacter = 10
+
+// textMax は TextLength がパラメーター以下かどうかを判定します
+// 書記素クラスターの数だけでは結合文字を重ねていくらでも長くできるため、コードポイント数も制限します
+func textMax(fl validator.FieldLevel) bool {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		panic("validators: invalid text_max parameter: " + fl.Param())
+	}
+	s := fl.Field().String()
+	if utf8.RuneCountInString(s) > limit*MaxRunesPerCharacter {
+		return false
+	}
+	return TextLength(s) <= limit
+}
+
+// urlPattern は文章中の URL です
+var urlPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s　]+`)
+
+// noURLOnly は URL を除いた残りに文字が含まれるかどうかを判定します（空の値は判定しない）
+func noURLOnly(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if !urlPattern.MatchString(s) {
+		return true
+	}
+	rest := urlPattern.ReplaceAllString(s, "")
+	return strings.IndexFunc(rest, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsNumber(r)
+	}) >= 0
+}
+
+// containsNGWord は禁止語句を含むかどうかを判定します
+// 空白を挟んで書かれた場合も検出できるよう、空白を除いて比較します
+func containsNGWord(s string, ngWords []string) bool {
+	if len(ngWords) == 0 {
+		return false
+	}
+	folded := foldText(s)
+	for _, word := range ngWords {
+		if strings.Contains(folded, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// foldText は禁止語句の比較用に NFKC 正規化と小文字化を行い、空白を除きます
+func foldText(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(norm.NFKC.String(s))), "")
+}
@@ -0,0 +1,197 @@
+package validators
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ja"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/text/language"
+)
+
+// messages は言語ごとの検証エラーのメッセージです
+// {0} は項目の表示名、{1} はルールのパラメーターに置き換えます
+// min・max は項目の種類（文字列・件数・数値）ごとに使い分け、text_min・text_max は文字列のものを使用します
+var messages = map[string]map[string]string{
+	"ja": {
+		"required":    "{0}は必須です",
+		"min-string":  "{0}は{1}文字以上で入力してください",
+		"min-items":   "{0}は{1}件以上指定してください",
+		"min-number":  "{0}は{1}以上で指定してください",
+		"max-string":  "{0}は{1}文字以内で入力してください",
+		"max-items":   "{0}は{1}件以内で指定してください",
+		"max-number":  "{0}は{1}以下で指定してください",
+		"oneof":       "{0}は次のいずれかを指定してください: {1}",
+		"datetime":    "{0}は{1}の形式で入力してください",
+		"alphanum":    "{0}は半角英数字で入力してください",
+		"category":    "{0}は有効なカテゴリを指定してください",
+		"no_url_only": "{0}をURLだけにすることはできません",
+		"no_ng_word":  "{0}に使用できない語句が含まれています",
+		"invalid":     "{0}が正しくありません",
+	},
+	"en": {
+		"required":    "{0} is required",
+		"min-string":  "{0} must be at least {1} characters",
+		"min-items":   "{0} must be at least {1} items",
+		"min-number":  "{0} must be {1} or greater",
+		"max-string":  "{0} must be at most {1} characters",
+		"max-items":   "{0} must be at most {1} items",
+		"max-number":  "{0} must be {1} or less",
+		"oneof":       "{0} must be one of: {1}",
+		"datetime":    "{0} must be a date in the format {1}",
+		"alphanum":    "{0} must contain only letters and numbers",
+		"category":    "{0} must be an available category",
+		"no_url_only": "{0} must not consist only of URLs",
+		"no_ng_word":  "{0} contains a prohibited word",
+		"invalid":     "{0} is invalid",
+	},
+}
+
+// separators は oneof の候補を区切る文字です
+var separators = map[string]string{
+	"ja": "、",
+	"en": ", ",
+}
+
+// fieldNames はメッセージに使用する項目の表示名です（JSON のキーのパス、配列の添字は除く）
+// 英語のメッセージ、および表示名の無い項目は JSON のキーをそのまま使用します
+var fieldNames = map[string]map[string]string{
+	"ja": {
+		"title":                       "タイトル",
+		"content":                     "本文",
+		"category":                    "カテゴリ",
+		"company_name":                "企業名",
+		"job_type":                    "職種",
+		"tags":                        "タグ",
+		"interview.stage":             "選考段階",
+		"interview.format":            "面接形式",
+		"interview.duration_minutes":  "面接時間",
+		"interview.interviewer_count": "面接官の人数",
+		"interview.outcome":           "選考結果",
+		"interview.interviewed_on":    "面接日",
+		"post_id":                     "投稿ID",
+		"parent_id":                   "返信先のコメントID",
+		"reason":                      "理由",
+		"detail":                      "詳細",
+		"action":                      "対応",
+		"note":                        "メモ",
+		"name":                        "企業名",
+		"kana":                        "読み",
+		"aliases":                     "別名",
+		"source_id":                   "統合元の企業ID",
+		"username":                    "ユーザー名",
+		"password":                    "パスワード",
+	},
+}
+
+// translatedTags はメッセージを用意しているルールです
+var translatedTags = []string{
+	"required", "min", "max", "oneof", "datetime", "alphanum",
+	"category", "text_min", "text_max", "no_url_only", "no_ng_word",
+}
+
+// Translator は検証エラーのメッセージを利用者の言語に翻訳します
+// 日本語と英語に対応し、それ以外の言語の場合は日本語を使用します
+type Translator struct {
+	uni *ut.UniversalTranslator
+}
+
+// NewTranslator は日本語・英語のメッセージを v に登録した Translator を作成します
+func NewTranslator(v *validator.Validate) (*Translator, error) {
+	uni := ut.New(ja.New(), ja.New(), en.New())
+
+	for locale, texts := range messages {
+		trans, _ := uni.GetTranslator(locale)
+		for key, text := range texts {
+			if err := trans.Add(key, text, false); err != nil {
+				return nil, err
+			}
+		}
+		for _, tag := range translatedTags {
+			if err := v.RegisterTranslation(tag, trans, registerNothing, Translate); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &Translator{uni: uni}, nil
+}
+
+// FromAcceptLanguage は Accept-Language ヘッダーの優先順位に従って使用する言語の翻訳を返します
+func (t *Translator) FromAcceptLanguage(header string) ut.Translator {
+	tags, _, _ := language.ParseAcceptLanguage(header)
+	for _, tag := range tags {
+		base, _ := tag.Base()
+		if trans, ok := t.uni.GetTranslator(base.String()); ok {
+			return trans
+		}
+	}
+	return t.uni.GetFallback()
+}
+
+// Translate は検証エラーを trans の言語のメッセージに変換します
+// メッセージを用意していないルールは「正しくありません」の汎用のメッセージを返します
+func Translate(trans ut.Translator, fe validator.FieldError) string {
+	locale := trans.Locale()
+	name := FieldName(locale, FieldPath(fe))
+
+	key := fe.Tag()
+	switch key {
+	case "min", "max":
+		key += "-" + sizeKind(fe)
+	case "text_min":
+		key = "min-string"
+	case "text_max":
+		key = "max-string"
+	}
+
+	param := fe.Param()
+	if fe.Tag() == "oneof" {
+		param = strings.Join(strings.Fields(param), separators[locale])
+	}
+
+	message, err := trans.T(key, name, param)
+	if err != nil {
+		message, _ = trans.T("invalid", name, param)
+	}
+	return message
+}
+
+// FieldPath は先頭の構造体名を除いた項目のパスを返します
+// 例: PostCreateRequest.interview.stage → interview.stage
+func FieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if i := strings.IndexByte(ns, '.'); i >= 0 {
+		return ns[i+1:]
+	}
+	return fe.Field()
+}
+
+// indexPattern は項目のパスに含まれる配列の添字です
+var indexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// FieldName は項目のパスに対応する locale の表示名を返します
+func FieldName(locale, path string) string {
+	if name, ok := fieldNames[locale][indexPattern.ReplaceAllString(path, "")]; ok {
+		return name
+	}
+	return path
+}
+
+// sizeKind は min・max のメッセージに使用する項目の種類を返します
+func sizeKind(fe validator.FieldError) string {
+	switch fe.Kind().String() {
+	case "string":
+		return "string"
+	case "slice", "array", "map":
+		return "items"
+	}
+	return "number"
+}
+
+// registerNothing はメッセージを NewTranslator でまとめて登録するため何もしません
+func registerNothing(ut.Translator) error {
+	return nil
+}
@@ -1,6 +1,9 @@
 package validators
 
 import (
+	"reflect"
+	"strings"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
@@ -18,6 +21,18 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 
 // New は新しいカスタムバリデーターのインスタンスを作成します
 // Echo のバリデーターインターフェースを実装しています
-func New() echo.Validator {
-	return &CustomValidator{validator: validator.New()}
+// NewValidate で作成したバリデーターをサービス層と共有して渡します
+func New(v *validator.Validate) echo.Validator {
+	return &CustomValidator{validator: v}
+}
+
+// RegisterJSONFieldNames は検証エラーの項目名に構造体のフィールド名ではなく JSON のキーを使用するよう設定します
+func RegisterJSONFieldNames(v *validator.Validate) {
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
 }
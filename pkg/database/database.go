@@ -4,6 +4,8 @@ import (
 	"log"
 
 	"github.com/latttchc/finding-forest-backend/internal/models"
+	"github.com/latttchc/finding-forest-backend/internal/repositories"
+	"github.com/latttchc/finding-forest-backend/internal/search"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -36,17 +38,68 @@ func Connect(dsn string) (*gorm.DB, error) {
 	return db, nil
 }
 
-// Migrate はデータベースマイグレーションを実行する
+// Migrate は未適用のマイグレーションを適用し、Go 側で算出が必要なデータを補完する
 func Migrate(db *gorm.DB) error {
-	// model migrate
-	err := db.AutoMigrate(
-		&models.Post{},
-		&models.Comment{},
-	)
+	// スキーマのマイグレーション（migrations/*.sql）
+	applied, err := MigrateUp(db)
 	if err != nil {
 		return err
 	}
 
-	log.Println("Database migration completed")
+	// 検索インデックス未作成の投稿を補完
+	if err := backfillSearchText(db); err != nil {
+		return err
+	}
+
+	// 企業未設定の投稿を企業に紐付け
+	if err := backfillCompanies(db); err != nil {
+		return err
+	}
+
+	log.Printf("Database migration completed (%d applied)", applied)
+	return nil
+}
+
+// backfillSearchText は search_text が未設定の投稿に検索用トークンを設定する
+func backfillSearchText(db *gorm.DB) error {
+	var posts []models.Post
+	return db.Unscoped().Select("id", "title", "content").
+		Where("search_text IS NULL OR search_text = ''").
+		FindInBatches(&posts, 500, func(_ *gorm.DB, _ int) error {
+			for _, post := range posts {
+				err := db.Model(&models.Post{}).Unscoped().Where("id = ?", post.ID).
+					UpdateColumn("search_text", search.IndexText(post.Title, post.Content)).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// backfillCompanies は company_id が未設定の投稿を企業名から企業に紐付ける
+func backfillCompanies(db *gorm.DB) error {
+	var names []string
+	err := db.Model(&models.Post{}).Unscoped().
+		Where("company_id IS NULL").
+		Distinct().
+		Pluck("company_name", &names).Error
+	if err != nil {
+		return err
+	}
+
+	companyRepo := repositories.NewCompanyRepository(db)
+	for _, name := range names {
+		company, err := companyRepo.Resolve(name)
+		if err != nil {
+			return err
+		}
+		err = db.Model(&models.Post{}).Unscoped().
+			Where("company_id IS NULL AND company_name = ?", name).
+			Updates(map[string]interface{}{"company_id": company.ID, "company_name": company.Name}).Error
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
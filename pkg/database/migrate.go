@@ -0,0 +1,242 @@
+package database
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrationFiles は NNNN_name.up.sql / NNNN_name.down.sql 形式のマイグレーションファイル
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationFilePattern はマイグレーションファイル名の形式
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// migrationLockKey は複数のレプリカが同時にマイグレーションを実行しないよう取得するアドバイザリーロックのキー
+const migrationLockKey int64 = 4_207_318_801
+
+// Migration はバージョン管理されたマイグレーション
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+	down    string
+}
+
+// MigrationStatus はマイグレーションの適用状況
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time // 未適用の場合は nil
+}
+
+// schemaMigration は schema_migrations テーブルの行
+type schemaMigration struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+// loadMigrations は埋め込まれたマイグレーションファイルをバージョン順に読み込む
+func loadMigrations() ([]Migration, error) {
+	return parseMigrations(migrationFiles)
+}
+
+// parseMigrations は fsys の migrations ディレクトリのマイグレーションファイルをバージョン順に読み込む
+func parseMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		m := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+		body, err := fs.ReadFile(fsys, "migrations/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != m[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %s, %s", version, migration.Name, m[2])
+		}
+		if m[3] == "up" {
+			migration.up = string(body)
+		} else {
+			migration.down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// MigrateUp は未適用のマイグレーションをバージョン順にすべて適用し、適用した件数を返す
+func MigrateUp(db *gorm.DB) (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = withMigrationLock(db, func(conn *gorm.DB) error {
+		applied, err := appliedMigrations(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.up).Error; err != nil {
+					return err
+				}
+				return tx.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, NOW())",
+					migration.Version, migration.Name).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			log.Printf("Applied migration %d_%s", migration.Version, migration.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// MigrateDown は適用済みのマイグレーションを新しいものから steps 件取り消し、取り消した件数を返す
+func MigrateDown(db *gorm.DB, steps int) (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	byVersion := make(map[int64]Migration, len(migrations))
+	for _, migration := range migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	count := 0
+	err = withMigrationLock(db, func(conn *gorm.DB) error {
+		var versions []int64
+		err := conn.Table("schema_migrations").Order("version DESC").Limit(steps).Pluck("version", &versions).Error
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			migration, ok := byVersion[version]
+			if !ok {
+				return fmt.Errorf("migration %d is applied but its files were not found", version)
+			}
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.down).Error; err != nil {
+					return err
+				}
+				return tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to roll back migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			log.Printf("Rolled back migration %d_%s", migration.Version, migration.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// MigrationStatuses はすべてのマイグレーションの適用状況をバージョン順に返す
+func MigrationStatuses(db *gorm.DB) ([]MigrationStatus, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied map[int64]schemaMigration
+	err = withMigrationLock(db, func(conn *gorm.DB) error {
+		applied, err = appliedMigrations(conn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, len(migrations))
+	for i, migration := range migrations {
+		statuses[i] = MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			appliedAt := row.AppliedAt
+			statuses[i].AppliedAt = &appliedAt
+		}
+	}
+	return statuses, nil
+}
+
+// withMigrationLock はアドバイザリーロックを取得した単一のコネクションで fn を実行する
+// セッション単位のロックのため、取得・解放・マイグレーションを同じコネクションで行う
+func withMigrationLock(db *gorm.DB, fn func(conn *gorm.DB) error) error {
+	return db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockKey).Error; err != nil {
+				log.Printf("Failed to release migration lock: %v", err)
+			}
+		}()
+
+		err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL
+		)`).Error
+		if err != nil {
+			return err
+		}
+		return fn(conn)
+	})
+}
+
+// appliedMigrations は適用済みのマイグレーションをバージョンごとに返す
+func appliedMigrations(conn *gorm.DB) (map[int64]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := conn.Table("schema_migrations").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
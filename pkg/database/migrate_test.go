@@ -0,0 +1,80 @@
+package database
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// TestLoadMigrations は埋め込まれたマイグレーションが連番で、すべて up・down の両方を持つことを確認します
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migrations are embedded")
+	}
+	for i, m := range migrations {
+		if m.Version != int64(i+1) {
+			t.Errorf("migration %d_%s: want version %d", m.Version, m.Name, i+1)
+		}
+		if strings.TrimSpace(m.up) == "" || strings.TrimSpace(m.down) == "" {
+			t.Errorf("migration %d_%s has an empty up or down file", m.Version, m.Name)
+		}
+	}
+}
+
+// TestParseMigrations はファイル名からバージョン・名前を読み取り、バージョン順に並べることを確認します
+func TestParseMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/0010_add_index.up.sql":      {Data: []byte("CREATE INDEX")},
+		"migrations/0010_add_index.down.sql":    {Data: []byte("DROP INDEX")},
+		"migrations/0002_create_table.up.sql":   {Data: []byte("CREATE TABLE")},
+		"migrations/0002_create_table.down.sql": {Data: []byte("DROP TABLE")},
+	}
+
+	migrations, err := parseMigrations(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Migration{
+		{Version: 2, Name: "create_table", up: "CREATE TABLE", down: "DROP TABLE"},
+		{Version: 10, Name: "add_index", up: "CREATE INDEX", down: "DROP INDEX"},
+	}
+	if len(migrations) != len(want) {
+		t.Fatalf("got %d migrations, want %d", len(migrations), len(want))
+	}
+	for i := range want {
+		if migrations[i] != want[i] {
+			t.Errorf("migrations[%d] = %+v, want %+v", i, migrations[i], want[i])
+		}
+	}
+}
+
+// TestParseMigrationsInvalid は不正なファイル名・不足しているファイル・重複したバージョンを拒否することを確認します
+func TestParseMigrationsInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+	}{
+		{"invalid name", []string{"migrations/0001_init.sql"}},
+		{"missing down", []string{"migrations/0001_init.up.sql"}},
+		{"missing up", []string{"migrations/0001_init.down.sql"}},
+		{"duplicate version", []string{
+			"migrations/0001_init.up.sql", "migrations/0001_init.down.sql",
+			"migrations/0001_other.up.sql", "migrations/0001_other.down.sql",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{}
+			for _, name := range tt.files {
+				fsys[name] = &fstest.MapFile{Data: []byte("SELECT 1")}
+			}
+			if _, err := parseMigrations(fsys); err == nil {
+				t.Error("parseMigrations succeeded, want an error")
+			}
+		})
+	}
+}
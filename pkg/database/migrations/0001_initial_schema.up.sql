@@ -0,0 +1,202 @@
+-- 初期スキーマ
+-- AutoMigrate で作成済みのデータベースにも適用できるよう、すべて IF NOT EXISTS で作成する
+-- 既存の posts・comments テーブルは CREATE TABLE が省略されるため、後から追加したカラムを補ってからインデックスを作成する
+
+CREATE TABLE IF NOT EXISTS companies (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    normalized_name VARCHAR(100) NOT NULL,
+    kana VARCHAR(100),
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_companies_normalized_name ON companies (normalized_name);
+
+CREATE TABLE IF NOT EXISTS company_aliases (
+    id BIGSERIAL PRIMARY KEY,
+    company_id BIGINT NOT NULL,
+    alias VARCHAR(50) NOT NULL,
+    normalized_alias VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_companies_aliases FOREIGN KEY (company_id) REFERENCES companies (id)
+);
+CREATE INDEX IF NOT EXISTS idx_company_aliases_company_id ON company_aliases (company_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_company_aliases_normalized_alias ON company_aliases (normalized_alias);
+
+CREATE TABLE IF NOT EXISTS categories (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(20) NOT NULL,
+    description VARCHAR(200),
+    display_order BIGINT NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_name ON categories (name);
+
+CREATE TABLE IF NOT EXISTS posts (
+    id BIGSERIAL PRIMARY KEY,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    category TEXT NOT NULL,
+    company_name TEXT NOT NULL,
+    company_id BIGINT,
+    job_type TEXT,
+    edit_token_hash VARCHAR(64),
+    comment_count BIGINT NOT NULL DEFAULT 0,
+    reaction_count BIGINT NOT NULL DEFAULT 0,
+    bumped_at TIMESTAMPTZ,
+    hidden_at TIMESTAMPTZ,
+    search_text TEXT,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_posts_company FOREIGN KEY (company_id) REFERENCES companies (id)
+);
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS company_id BIGINT;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS edit_token_hash VARCHAR(64);
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS comment_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS reaction_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS bumped_at TIMESTAMPTZ;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_text TEXT;
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_posts_company') THEN
+        ALTER TABLE posts ADD CONSTRAINT fk_posts_company FOREIGN KEY (company_id) REFERENCES companies (id);
+    END IF;
+END $$;
+CREATE INDEX IF NOT EXISTS idx_posts_company_id ON posts (company_id);
+CREATE INDEX IF NOT EXISTS idx_posts_hidden_at ON posts (hidden_at);
+CREATE INDEX IF NOT EXISTS idx_posts_deleted_at ON posts (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_posts_created_at_id ON posts (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_posts_bumped_at_id ON posts (bumped_at, id);
+CREATE INDEX IF NOT EXISTS idx_posts_comment_count_id ON posts (comment_count, id);
+CREATE INDEX IF NOT EXISTS idx_posts_reaction_count_id ON posts (reaction_count, id);
+CREATE INDEX IF NOT EXISTS idx_posts_search_tokens ON posts USING GIN (string_to_array(search_text, ' '));
+
+CREATE TABLE IF NOT EXISTS post_interviews (
+    id BIGSERIAL PRIMARY KEY,
+    post_id BIGINT NOT NULL,
+    stage VARCHAR(10),
+    format VARCHAR(10),
+    duration_minutes BIGINT,
+    interviewer_count BIGINT,
+    outcome VARCHAR(10),
+    interviewed_on DATE,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT fk_posts_interview FOREIGN KEY (post_id) REFERENCES posts (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_post_interviews_post_id ON post_interviews (post_id);
+CREATE INDEX IF NOT EXISTS idx_post_interviews_stage ON post_interviews (stage);
+CREATE INDEX IF NOT EXISTS idx_post_interviews_format ON post_interviews (format);
+CREATE INDEX IF NOT EXISTS idx_post_interviews_outcome ON post_interviews (outcome);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(30) NOT NULL,
+    normalized_name VARCHAR(60) NOT NULL,
+    created_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_normalized_name ON tags (normalized_name);
+
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id BIGINT NOT NULL,
+    tag_id BIGINT NOT NULL,
+    PRIMARY KEY (post_id, tag_id),
+    CONSTRAINT fk_post_tags_post FOREIGN KEY (post_id) REFERENCES posts (id),
+    CONSTRAINT fk_post_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id)
+);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id BIGSERIAL PRIMARY KEY,
+    post_id BIGINT NOT NULL,
+    parent_id BIGINT,
+    root_id BIGINT,
+    depth BIGINT NOT NULL DEFAULT 0,
+    content TEXT NOT NULL,
+    edit_token_hash VARCHAR(64),
+    reaction_count BIGINT NOT NULL DEFAULT 0,
+    hidden_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT fk_posts_comments FOREIGN KEY (post_id) REFERENCES posts (id)
+);
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS parent_id BIGINT;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS root_id BIGINT;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS depth BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS edit_token_hash VARCHAR(64);
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS reaction_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_root_id ON comments (root_id);
+CREATE INDEX IF NOT EXISTS idx_comments_hidden_at ON comments (hidden_at);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_comments_post_id_created_at_id ON comments (post_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_comments_post_id_reaction_count_id ON comments (post_id, reaction_count, id);
+
+CREATE TABLE IF NOT EXISTS reactions (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL,
+    target_id BIGINT NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    client_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reactions_unique ON reactions (target_type, target_id, kind, client_hash);
+CREATE INDEX IF NOT EXISTS idx_reactions_target ON reactions (target_type, target_id);
+
+CREATE TABLE IF NOT EXISTS reports (
+    id BIGSERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL,
+    target_id BIGINT NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    detail TEXT,
+    client_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'open',
+    resolution_note TEXT,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_unique ON reports (target_type, target_id, client_hash);
+CREATE INDEX IF NOT EXISTS idx_reports_target ON reports (target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports (status);
+
+CREATE TABLE IF NOT EXISTS moderators (
+    id BIGSERIAL PRIMARY KEY,
+    username VARCHAR(50) NOT NULL,
+    password_hash TEXT NOT NULL,
+    last_login_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_moderators_username ON moderators (username);
+
+CREATE TABLE IF NOT EXISTS moderator_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    moderator_id BIGINT NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_moderator_sessions_moderator FOREIGN KEY (moderator_id) REFERENCES moderators (id)
+);
+CREATE INDEX IF NOT EXISTS idx_moderator_sessions_moderator_id ON moderator_sessions (moderator_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_moderator_sessions_token_hash ON moderator_sessions (token_hash);
+CREATE INDEX IF NOT EXISTS idx_moderator_sessions_expires_at ON moderator_sessions (expires_at);
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    moderator_id BIGINT NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    target_type VARCHAR(16),
+    target_id BIGINT,
+    detail TEXT,
+    created_at TIMESTAMPTZ,
+    CONSTRAINT fk_audit_logs_moderator FOREIGN KEY (moderator_id) REFERENCES moderators (id)
+);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_moderator_id ON audit_logs (moderator_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs (created_at);
@@ -0,0 +1,4 @@
+-- 投稿で使用されていない初期カテゴリのみ削除する
+DELETE FROM categories
+WHERE name IN ('面接', 'ES', '企業情報', 'その他')
+  AND NOT EXISTS (SELECT 1 FROM posts WHERE posts.category = categories.name);
@@ -0,0 +1,8 @@
+-- 初期カテゴリ
+-- 管理者が変更した説明・表示順・有効フラグを上書きしないよう、同名のカテゴリがある場合は何もしない
+INSERT INTO categories (name, description, display_order, active, created_at, updated_at) VALUES
+    ('面接', '面接の体験談・質問内容', 10, TRUE, NOW(), NOW()),
+    ('ES', 'エントリーシートの設問・書き方', 20, TRUE, NOW(), NOW()),
+    ('企業情報', '社風・待遇・選考フローなどの企業情報', 30, TRUE, NOW(), NOW()),
+    ('その他', 'その他の就職活動に関する話題', 40, TRUE, NOW(), NOW())
+ON CONFLICT (name) DO NOTHING;
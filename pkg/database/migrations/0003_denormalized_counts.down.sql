@@ -0,0 +1,2 @@
+ALTER TABLE posts ALTER COLUMN bumped_at DROP NOT NULL;
+ALTER TABLE posts ALTER COLUMN bumped_at DROP DEFAULT;
@@ -0,0 +1,23 @@
+-- 非正規化したコメント数・リアクション数・最終コメント日時を集計し直す
+UPDATE posts SET comment_count = (
+    SELECT COUNT(*) FROM comments
+    WHERE comments.post_id = posts.id AND comments.deleted_at IS NULL AND comments.hidden_at IS NULL
+);
+
+UPDATE posts SET reaction_count = (
+    SELECT COUNT(*) FROM reactions
+    WHERE reactions.target_type = 'post' AND reactions.target_id = posts.id
+);
+
+UPDATE comments SET reaction_count = (
+    SELECT COUNT(*) FROM reactions
+    WHERE reactions.target_type = 'comment' AND reactions.target_id = comments.id
+);
+
+-- 最終コメント日時はコメントが無い場合は作成日時とし、以降は必須とする
+UPDATE posts SET bumped_at = GREATEST(posts.created_at, (
+    SELECT MAX(comments.created_at) FROM comments WHERE comments.post_id = posts.id
+)) WHERE bumped_at IS NULL;
+
+ALTER TABLE posts ALTER COLUMN bumped_at SET DEFAULT NOW();
+ALTER TABLE posts ALTER COLUMN bumped_at SET NOT NULL;
@@ -0,0 +1,10 @@
+-- 上限を超える値がある場合は失敗する
+ALTER TABLE tags ALTER COLUMN normalized_name TYPE VARCHAR(60);
+ALTER TABLE tags ALTER COLUMN name TYPE VARCHAR(30);
+
+ALTER TABLE company_aliases ALTER COLUMN normalized_alias TYPE VARCHAR(100);
+ALTER TABLE company_aliases ALTER COLUMN alias TYPE VARCHAR(50);
+
+ALTER TABLE companies ALTER COLUMN kana TYPE VARCHAR(100);
+ALTER TABLE companies ALTER COLUMN normalized_name TYPE VARCHAR(100);
+ALTER TABLE companies ALTER COLUMN name TYPE VARCHAR(50);
@@ -0,0 +1,11 @@
+-- 企業名・別名・タグ名の文字数は書記素クラスター単位で検証するため、
+-- 結合文字や絵文字を含む名前が VARCHAR の上限（コードポイント数）を超えないよう TEXT に変更する
+ALTER TABLE companies ALTER COLUMN name TYPE TEXT;
+ALTER TABLE companies ALTER COLUMN normalized_name TYPE TEXT;
+ALTER TABLE companies ALTER COLUMN kana TYPE TEXT;
+
+ALTER TABLE company_aliases ALTER COLUMN alias TYPE TEXT;
+ALTER TABLE company_aliases ALTER COLUMN normalized_alias TYPE TEXT;
+
+ALTER TABLE tags ALTER COLUMN name TYPE TEXT;
+ALTER TABLE tags ALTER COLUMN normalized_name TYPE TEXT;
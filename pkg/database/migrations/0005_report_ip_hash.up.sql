@@ -0,0 +1,4 @@
+-- 通報の自動非表示はクライアント識別子ではなく接続元IPアドレスの数で判定する
+-- 既存の通報は接続元が分からないため、クライアントごとに別の接続元として扱う
+ALTER TABLE reports ADD COLUMN IF NOT EXISTS ip_hash VARCHAR(64) NOT NULL DEFAULT '';
+UPDATE reports SET ip_hash = client_hash WHERE ip_hash = '';
@@ -0,0 +1,3 @@
+-- 面接情報を登録できるカテゴリをカテゴリ名ではなくフラグで判定する
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS is_interview BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE categories SET is_interview = TRUE WHERE name = '面接';